@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// BinlogArchiveKey defines the key for the CronJob that archives the binary logs.
+func (b *Backup) BinlogArchiveKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-binlog", b.Name),
+		Namespace: b.Namespace,
+	}
+}
+
+// VerifyKey defines the key for the Job that verifies the Backups.
+func (b *Backup) VerifyKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-verify", b.Name),
+		Namespace: b.Namespace,
+	}
+}
+
+// VolumeSnapshotKey defines the key for the VolumeSnapshot taken at the given time.
+func (b *Backup) VolumeSnapshotKey(t time.Time) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-%s", b.Name, t.UTC().Format("20060102150405")),
+		Namespace: b.Namespace,
+	}
+}
@@ -3,7 +3,9 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"path"
 	"reflect"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -17,6 +19,14 @@ type BackupStorage struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	S3 *S3 `json:"s3,omitempty"`
+	// AzureBlob defines the configuration to store backups in Azure Blob Storage.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AzureBlob *AzureBlob `json:"azureBlob,omitempty"`
+	// GCS defines the configuration to store backups in Google Cloud Storage.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	GCS *GCS `json:"gcs,omitempty"`
 	// PersistentVolumeClaim is a Kubernetes PVC specification.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -39,6 +49,204 @@ func (b *BackupStorage) Validate() error {
 	if storageTypes != 1 {
 		return errors.New("exactly one storage type should be provided")
 	}
+	if b.S3 != nil {
+		if err := b.S3.Validate(); err != nil {
+			return fmt.Errorf("invalid S3: %v", err)
+		}
+	}
+	if b.AzureBlob != nil {
+		if err := b.AzureBlob.Validate(); err != nil {
+			return fmt.Errorf("invalid AzureBlob: %v", err)
+		}
+	}
+	return nil
+}
+
+// BackupMethod defines the method used to take a Backup.
+type BackupMethod string
+
+const (
+	// BackupMethodLogical takes a logical Backup using mariadb-dump.
+	BackupMethodLogical BackupMethod = "Logical"
+	// BackupMethodPhysical takes a physical Backup of the datadir using mariabackup.
+	BackupMethodPhysical BackupMethod = "Physical"
+	// BackupMethodVolumeSnapshot takes a CSI VolumeSnapshot of the storage PVC while the tables are locked.
+	BackupMethodVolumeSnapshot BackupMethod = "VolumeSnapshot"
+)
+
+// BackupTarget defines the MariaDB instance where the Backup is taken from.
+type BackupTarget string
+
+const (
+	// BackupTargetPrimary takes the Backup from the primary instance.
+	BackupTargetPrimary BackupTarget = "Primary"
+	// BackupTargetReplica takes the Backup from a healthy replica. The Backup fails if no replica is available.
+	BackupTargetReplica BackupTarget = "Replica"
+	// BackupTargetPreferReplica takes the Backup from a healthy replica, falling back to the primary if no replica is available.
+	BackupTargetPreferReplica BackupTarget = "PreferReplica"
+)
+
+// BackupEngine defines the tool used to take Logical Backups.
+type BackupEngine string
+
+const (
+	// BackupEngineMariadbDump takes Logical Backups in a single thread using mariadb-dump.
+	BackupEngineMariadbDump BackupEngine = "MariadbDump"
+	// BackupEngineMydumper takes Logical Backups in parallel using mydumper, and restores them using myloader.
+	BackupEngineMydumper BackupEngine = "Mydumper"
+)
+
+// CompressAlgorithm defines the algorithm used to compress Logical Backup files.
+type CompressAlgorithm string
+
+const (
+	// CompressNone stores Backup files uncompressed.
+	CompressNone CompressAlgorithm = "none"
+	// CompressGzip compresses Backup files with gzip.
+	CompressGzip CompressAlgorithm = "gzip"
+	// CompressZstd compresses Backup files with zstd.
+	CompressZstd CompressAlgorithm = "zstd"
+	// CompressBzip2 compresses Backup files with bzip2.
+	CompressBzip2 CompressAlgorithm = "bzip2"
+)
+
+var compressExtensions = map[CompressAlgorithm]string{
+	CompressGzip:  "gz",
+	CompressZstd:  "zst",
+	CompressBzip2: "bz2",
+}
+
+// IsCompressed determines whether the CompressAlgorithm compresses Backup files.
+func (c CompressAlgorithm) IsCompressed() bool {
+	_, ok := compressExtensions[c]
+	return ok
+}
+
+// Extension returns the file extension of the CompressAlgorithm, empty if it does not compress Backup files.
+func (c CompressAlgorithm) Extension() string {
+	return compressExtensions[c]
+}
+
+// BackupEncryption defines the client-side encryption of the Backup files.
+type BackupEncryption struct {
+	// KeySecretKeyRef is a reference to a Secret key containing the AES-256 key used to encrypt the Backup files.
+	// The key must be 32 bytes long, either raw or base64/hex encoded.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	KeySecretKeyRef corev1.SecretKeySelector `json:"keySecretKeyRef"`
+}
+
+// BinlogArchive defines how the binary logs are continuously archived to implement point-in-time recovery.
+type BinlogArchive struct {
+	// Enabled is a flag to enable binary log archiving. Binary logging must be enabled in the MariaDB.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Enabled bool `json:"enabled,omitempty"`
+	// Schedule defines when the closed binary logs are shipped to the Backup storage. It defaults to every 5 minutes.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Schedule *Schedule `json:"schedule,omitempty"`
+}
+
+// Mydumper defines the options of the mydumper engine, which dumps the tables in parallel into a directory.
+type Mydumper struct {
+	// Threads is the number of threads used to dump the tables. It defaults to 4.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Threads int32 `json:"threads,omitempty"`
+	// Rows splits the tables into chunks of this number of rows, allowing to dump and load a single table in parallel.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Rows int64 `json:"rows,omitempty"`
+	// ChunkFilesize splits the table files into chunks of this size in megabytes.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	ChunkFilesize int32 `json:"chunkFilesize,omitempty"`
+}
+
+// ThreadsOrDefault returns the number of threads used to dump the tables.
+func (m *Mydumper) ThreadsOrDefault() int32 {
+	if m == nil || m.Threads == 0 {
+		return 4
+	}
+	return m.Threads
+}
+
+// BackupVolumeSnapshot defines how the CSI VolumeSnapshots of the storage PVC are taken.
+type BackupVolumeSnapshot struct {
+	// VolumeSnapshotClassName is the name of the VolumeSnapshotClass used to take the VolumeSnapshots.
+	// The default VolumeSnapshotClass of the CSI driver is used when not provided.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// BackupVerify defines how the Backups are verified by restoring them into an ephemeral MariaDB instance.
+type BackupVerify struct {
+	// Enabled is a flag to enable the verification of Backups. Every new Backup is restored by a Job into an ephemeral
+	// MariaDB instance, where the SqlQuery is executed to check its sanity.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Enabled bool `json:"enabled,omitempty"`
+	// SqlQuery is the sanity query executed against the restored Backup. The verification fails if the query fails.
+	// It defaults to 'SELECT COUNT(*) FROM information_schema.tables;'.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SqlQuery string `json:"sqlQuery,omitempty"`
+	// Resouces describes the compute resource requirements of the ephemeral MariaDB instance.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// SqlQueryOrDefault returns the sanity query executed against the restored Backup.
+func (v *BackupVerify) SqlQueryOrDefault() string {
+	if v.SqlQuery == "" {
+		return "SELECT COUNT(*) FROM information_schema.tables;"
+	}
+	return v.SqlQuery
+}
+
+// BackupRetentionPolicy defines a grandfather-father-son retention policy. For every period, the most recent Backup is kept
+// for the latest N periods that contain Backups. A Backup is retained if any of the periods keeps it.
+type BackupRetentionPolicy struct {
+	// KeepHourly is the number of hourly Backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	KeepHourly int32 `json:"keepHourly,omitempty"`
+	// KeepDaily is the number of daily Backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	KeepDaily int32 `json:"keepDaily,omitempty"`
+	// KeepWeekly is the number of weekly Backups to keep. Weeks are ISO 8601 weeks, starting on Monday.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	KeepWeekly int32 `json:"keepWeekly,omitempty"`
+	// KeepMonthly is the number of monthly Backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	KeepMonthly int32 `json:"keepMonthly,omitempty"`
+	// KeepYearly is the number of yearly Backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	KeepYearly int32 `json:"keepYearly,omitempty"`
+}
+
+func (r *BackupRetentionPolicy) Validate() error {
+	if r.KeepHourly < 0 || r.KeepDaily < 0 || r.KeepWeekly < 0 || r.KeepMonthly < 0 || r.KeepYearly < 0 {
+		return errors.New("the number of Backups to keep must not be negative")
+	}
+	if r.KeepHourly == 0 && r.KeepDaily == 0 && r.KeepWeekly == 0 && r.KeepMonthly == 0 && r.KeepYearly == 0 {
+		return errors.New("at least one period must keep Backups")
+	}
 	return nil
 }
 
@@ -48,10 +256,62 @@ type BackupSpec struct {
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	MariaDBRef MariaDBRef `json:"mariaDbRef" webhook:"inmutable"`
-	// Storage to be used in the Backup.
-	// +kubebuilder:validation:Required
+	// Storage to be used in the Backup. It is required by all the methods but VolumeSnapshot.
+	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	Storage BackupStorage `json:"storage" webhook:"inmutable"`
+	Storage BackupStorage `json:"storage,omitempty" webhook:"inmutable"`
+	// Method to be used to take the Backup. Logical Backups are taken with mariadb-dump, whereas Physical Backups
+	// are taken with mariabackup by mounting the datadir of one of the MariaDB Pods. VolumeSnapshot Backups are
+	// CSI VolumeSnapshots of the storage PVC of one of the MariaDB Pods, taken while its tables are locked.
+	// It defaults to 'Logical'.
+	// +optional
+	// +kubebuilder:default=Logical
+	// +kubebuilder:validation:Enum=Logical;Physical;VolumeSnapshot
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Method BackupMethod `json:"method,omitempty" webhook:"inmutableinit"`
+	// Target defines the MariaDB instance where the Backup is taken from. A healthy replica is picked when taking
+	// the Backup from a replica, in order to avoid adding load to the primary. It defaults to 'Primary'.
+	// +optional
+	// +kubebuilder:default=Primary
+	// +kubebuilder:validation:Enum=Primary;Replica;PreferReplica
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Target BackupTarget `json:"target,omitempty"`
+	// Engine used to take Logical Backups. MariadbDump takes the Backup in a single thread, whereas Mydumper dumps the tables
+	// in parallel into a directory, which is archived as a tarball. It defaults to 'MariadbDump'.
+	// +optional
+	// +kubebuilder:default=MariadbDump
+	// +kubebuilder:validation:Enum=MariadbDump;Mydumper
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Engine BackupEngine `json:"engine,omitempty" webhook:"inmutableinit"`
+	// Mydumper defines the options of the Mydumper engine.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Mydumper *Mydumper `json:"mydumper,omitempty"`
+	// VolumeSnapshot defines how the VolumeSnapshots are taken by the VolumeSnapshot method.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	VolumeSnapshot *BackupVolumeSnapshot `json:"volumeSnapshot,omitempty"`
+	// Compression algorithm to be used in the Backup files. The mariadb-dump output is piped through the compressor,
+	// and the resulting files are decompressed transparently when restoring. It is only supported by Logical Backups.
+	// +optional
+	// +kubebuilder:validation:Enum=none;gzip;zstd;bzip2
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Compression CompressAlgorithm `json:"compression,omitempty"`
+	// Encryption defines the client-side encryption of the Backup files. The files are encrypted with AES-256-GCM before
+	// being pushed to the storage, and decrypted when restoring. Unencrypted Backup files are still restorable.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Encryption *BackupEncryption `json:"encryption,omitempty" webhook:"inmutableinit"`
+	// Databases to be backed up. A backup file is taken for every database, named after it, instead of a single backup file
+	// with all the databases. Database names may only contain letters, digits, '_' and '-'. It is only supported by Logical Backups.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Databases []string `json:"databases,omitempty"`
+	// Tables to be backed up, in '<database>.<table>' format. The tables are backed up in the backup file of their database,
+	// which only contains the selected tables. It is only supported by Logical Backups.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Tables []string `json:"tables,omitempty"`
 	// Args to be used in the Backup container.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -60,11 +320,33 @@ type BackupSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Schedule *Schedule `json:"schedule,omitempty"`
+	// BinlogArchive defines how the binary logs are archived alongside the backups to implement point-in-time recovery.
+	// It is only supported by Logical Backups.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	BinlogArchive *BinlogArchive `json:"binlogArchive,omitempty"`
 	// MaxRetention defines the retention policy for backups. Old backups will be cleaned up by the Backup Job.
 	// It defaults to 30 days.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	MaxRetention metav1.Duration `json:"maxRetention,omitempty" webhook:"inmutableinit"`
+	// RetentionPolicy defines a grandfather-father-son retention policy for backups. It has priority over MaxRetention,
+	// which still applies to the archived binary logs.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	RetentionPolicy *BackupRetentionPolicy `json:"retentionPolicy,omitempty" webhook:"inmutableinit"`
+	// CatalogLimit is the maximum number of backup files listed in the status. It defaults to 10.
+	// The oldest backup files are further dropped when the catalog exceeds the 4096 bytes of the Job termination message.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=25
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	CatalogLimit int32 `json:"catalogLimit,omitempty"`
+	// Verify defines how the Backups are verified after being taken by restoring them into an ephemeral MariaDB instance.
+	// The result is reported in the Verified condition, and failures are recorded as events.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Verify *BackupVerify `json:"verify,omitempty"`
 	// LogLevel to be used n the Backup Job. It defaults to 'info'.
 	// +optional
 	// +kubebuilder:default=info
@@ -104,6 +386,59 @@ type BackupSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// ServiceAccountName is the name of the ServiceAccount to be used by the Backup Pods.
+	// It allows authenticating against the storage via workload identity.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+}
+
+// BackupArtifact is a backup file available in the storage.
+type BackupArtifact struct {
+	// FileName is the name of the backup file.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	FileName string `json:"fileName"`
+	// Time is the point in time when the backup was taken.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Time metav1.Time `json:"time"`
+	// Database contained in the backup file. It is empty when the backup file contains all the databases.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Database string `json:"database,omitempty"`
+	// Size is the size of the backup file in bytes.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Size int64 `json:"size,omitempty"`
+	// GTID is the GTID position of the backup.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	GTID string `json:"gtid,omitempty"`
+	// Duration is the time taken by the Job to take the backup.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Location of the backup file in the storage.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Location string `json:"location,omitempty"`
+}
+
+// BackupVolumeSnapshotArtifact is a VolumeSnapshot taken by the VolumeSnapshot method.
+type BackupVolumeSnapshotArtifact struct {
+	// Name of the VolumeSnapshot.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Name string `json:"name"`
+	// Time is the point in time when the VolumeSnapshot was taken.
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Time metav1.Time `json:"time"`
+	// PersistentVolumeClaimName is the name of the storage PVC used as source of the VolumeSnapshot.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+	// ReadyToUse indicates whether the VolumeSnapshot is ready to be used as a data source for new PVCs.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	ReadyToUse bool `json:"readyToUse,omitempty"`
 }
 
 // BackupStatus defines the observed state of Backup
@@ -112,6 +447,22 @@ type BackupStatus struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=status,xDescriptors={"urn:alm:descriptor:io.kubernetes.conditions"}
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Backups are the latest backup files available in the storage, sorted from newest to oldest.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	Backups []BackupArtifact `json:"backups,omitempty"`
+	// VolumeSnapshots are the VolumeSnapshots taken by the VolumeSnapshot method, sorted from newest to oldest.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	VolumeSnapshots []BackupVolumeSnapshotArtifact `json:"volumeSnapshots,omitempty"`
+	// LastSuccessfulBackupTime is the last time a backup was successfully taken.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	LastSuccessfulBackupTime *metav1.Time `json:"lastSuccessfulBackupTime,omitempty"`
+	// LastScheduleTime is the last time a backup was scheduled.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
 }
 
 func (b *BackupStatus) SetCondition(condition metav1.Condition) {
@@ -143,15 +494,187 @@ func (b *Backup) IsComplete() bool {
 	return meta.IsStatusConditionTrue(b.Status.Conditions, ConditionTypeComplete)
 }
 
+func (b *Backup) IsPhysical() bool {
+	return b.Spec.Method == BackupMethodPhysical
+}
+
+// IsVolumeSnapshot determines whether the Backup is taken as CSI VolumeSnapshots of the storage PVC.
+func (b *Backup) IsVolumeSnapshot() bool {
+	return b.Spec.Method == BackupMethodVolumeSnapshot
+}
+
+// IsMydumperEngine determines whether the Backup is taken with mydumper.
+func (b *Backup) IsMydumperEngine() bool {
+	return b.Spec.Engine == BackupEngineMydumper
+}
+
+// IsDatabaseSelected determines whether specific databases or tables are backed up, taking a backup file per database.
+func (b *Backup) IsDatabaseSelected() bool {
+	return len(b.Spec.Databases) > 0 || len(b.Spec.Tables) > 0
+}
+
+// IsReplicaTarget determines whether the Backup should be taken from a replica.
+func (b *Backup) IsReplicaTarget() bool {
+	return b.Spec.Target == BackupTargetReplica || b.Spec.Target == BackupTargetPreferReplica
+}
+
+func (b *Backup) IsBinlogArchiveEnabled() bool {
+	return b.Spec.BinlogArchive != nil && b.Spec.BinlogArchive.Enabled
+}
+
+func (b *Backup) IsCompressed() bool {
+	return b.Spec.Compression.IsCompressed()
+}
+
+func (b *Backup) IsEncrypted() bool {
+	return b.Spec.Encryption != nil
+}
+
+// IsStreaming determines whether the Backup is streamed to S3 without being staged in a local volume.
+func (b *Backup) IsStreaming() bool {
+	return b.Spec.Storage.S3.IsStreaming()
+}
+
+func (b *Backup) IsVerifyEnabled() bool {
+	return b.Spec.Verify != nil && b.Spec.Verify.Enabled
+}
+
 func (b *Backup) Validate() error {
 	if b.Spec.Schedule != nil {
 		if err := b.Spec.Schedule.Validate(); err != nil {
 			return fmt.Errorf("invalid Schedule: %v", err)
 		}
 	}
-	if err := b.Spec.Storage.Validate(); err != nil {
+	if b.IsCompressed() && b.IsPhysical() {
+		return errors.New("compression is only supported by Logical Backups")
+	}
+	if b.IsVolumeSnapshot() {
+		if err := b.validateVolumeSnapshot(); err != nil {
+			return err
+		}
+	} else if err := b.Spec.Storage.Validate(); err != nil {
 		return fmt.Errorf("invalid Storage: %v", err)
 	}
+	if b.Spec.RetentionPolicy != nil {
+		if err := b.Spec.RetentionPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid RetentionPolicy: %v", err)
+		}
+	}
+	if b.IsDatabaseSelected() {
+		if err := b.validateDatabaseSelection(); err != nil {
+			return err
+		}
+	}
+	if b.IsMydumperEngine() {
+		if err := b.validateMydumperEngine(); err != nil {
+			return err
+		}
+	}
+	if b.IsStreaming() {
+		if err := b.validateStreaming(); err != nil {
+			return err
+		}
+	}
+	if b.IsBinlogArchiveEnabled() {
+		if b.IsPhysical() {
+			return errors.New("binlog archiving is only supported by Logical Backups")
+		}
+		if b.IsEncrypted() {
+			return errors.New("binlog archiving is not supported by encrypted Backups")
+		}
+		if b.Spec.BinlogArchive.Schedule != nil {
+			if err := b.Spec.BinlogArchive.Schedule.Validate(); err != nil {
+				return fmt.Errorf("invalid BinlogArchive Schedule: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Backup) validateStreaming() error {
+	if b.IsPhysical() || b.IsMydumperEngine() {
+		return errors.New("streaming is only supported by Logical Backups taken with mariadb-dump")
+	}
+	if b.IsDatabaseSelected() {
+		return errors.New("streaming is not supported along with selecting databases and tables")
+	}
+	if b.IsEncrypted() {
+		return errors.New("streaming is not supported by encrypted Backups")
+	}
+	if b.IsBinlogArchiveEnabled() {
+		return errors.New("streaming is not supported along with binlog archiving")
+	}
+	return nil
+}
+
+func (b *Backup) validateDatabaseSelection() error {
+	if b.IsPhysical() {
+		return errors.New("selecting databases and tables is only supported by Logical Backups")
+	}
+	if b.IsBinlogArchiveEnabled() {
+		return errors.New("selecting databases and tables is not supported along with binlog archiving")
+	}
+	for _, database := range b.Spec.Databases {
+		if !IsValidIdentifier(database) {
+			return fmt.Errorf("invalid database '%s'", database)
+		}
+	}
+	for _, table := range b.Spec.Tables {
+		database, tableName, ok := strings.Cut(table, ".")
+		if !ok || !IsValidIdentifier(database) || !IsValidIdentifier(tableName) {
+			return fmt.Errorf("invalid table '%s', it must be in '<database>.<table>' format", table)
+		}
+	}
+	return nil
+}
+
+func (b *Backup) validateMydumperEngine() error {
+	if b.IsPhysical() {
+		return errors.New("the Mydumper engine is only supported by Logical Backups")
+	}
+	if b.IsDatabaseSelected() {
+		return errors.New("selecting databases and tables is not supported by the Mydumper engine")
+	}
+	if b.IsBinlogArchiveEnabled() {
+		return errors.New("binlog archiving is not supported by the Mydumper engine")
+	}
+	if b.IsVerifyEnabled() {
+		return errors.New("verification is not supported by the Mydumper engine")
+	}
+	if b.Spec.Compression == CompressBzip2 {
+		return errors.New("bzip2 compression is not supported by the Mydumper engine")
+	}
+	return nil
+}
+
+func (b *Backup) validateVolumeSnapshot() error {
+	if !reflect.ValueOf(b.Spec.Storage).IsZero() {
+		return errors.New("storage is not supported by VolumeSnapshot Backups")
+	}
+	if !b.IsReplicaTarget() {
+		return errors.New("VolumeSnapshot Backups lock the tables, therefore they must be taken from a Replica or PreferReplica target")
+	}
+	if b.IsCompressed() {
+		return errors.New("compression is not supported by VolumeSnapshot Backups")
+	}
+	if b.IsEncrypted() {
+		return errors.New("encryption is not supported by VolumeSnapshot Backups")
+	}
+	if b.IsMydumperEngine() {
+		return errors.New("the Mydumper engine is only supported by Logical Backups")
+	}
+	if b.IsDatabaseSelected() {
+		return errors.New("selecting databases and tables is only supported by Logical Backups")
+	}
+	if b.IsBinlogArchiveEnabled() {
+		return errors.New("binlog archiving is only supported by Logical Backups")
+	}
+	if b.IsVerifyEnabled() {
+		return errors.New("verification is not supported by VolumeSnapshot Backups")
+	}
+	if b.Spec.RetentionPolicy != nil {
+		return errors.New("retentionPolicy is not supported by VolumeSnapshot Backups, use maxRetention instead")
+	}
 	return nil
 }
 
@@ -162,10 +685,15 @@ func (b *Backup) SetDefaults() {
 	if b.Spec.BackoffLimit == 0 {
 		b.Spec.BackoffLimit = 5
 	}
+	if b.IsBinlogArchiveEnabled() && b.Spec.BinlogArchive.Schedule == nil {
+		b.Spec.BinlogArchive.Schedule = &Schedule{
+			Cron: "*/5 * * * *",
+		}
+	}
 }
 
 func (b *Backup) Volume() (*corev1.VolumeSource, error) {
-	if b.Spec.Storage.S3 != nil {
+	if b.Spec.Storage.S3 != nil || b.Spec.Storage.AzureBlob != nil || b.Spec.Storage.GCS != nil {
 		return &corev1.VolumeSource{
 			EmptyDir: &corev1.EmptyDirVolumeSource{},
 		}, nil
@@ -183,6 +711,39 @@ func (b *Backup) Volume() (*corev1.VolumeSource, error) {
 	return nil, errors.New("unable to get volume for Backup")
 }
 
+// CatalogLimitOrDefault returns the maximum number of backup files listed in the status.
+func (b *Backup) CatalogLimitOrDefault() int {
+	if b.Spec.CatalogLimit == 0 {
+		return 10
+	}
+	return int(b.Spec.CatalogLimit)
+}
+
+// FileLocation returns the location of a backup file in the storage.
+// An empty location is returned for Kubernetes volumes other than PVCs and NFS.
+func (b *Backup) FileLocation(fileName string) string {
+	storage := b.Spec.Storage
+	switch {
+	case storage.S3 != nil:
+		return fmt.Sprintf("s3://%s/%s%s", storage.S3.Bucket, storage.S3.Prefix, fileName)
+	case storage.AzureBlob != nil:
+		serviceURL := storage.AzureBlob.ServiceURL
+		if serviceURL == "" {
+			serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net", storage.AzureBlob.StorageAccount)
+		}
+		return fmt.Sprintf("%s/%s/%s%s", strings.TrimSuffix(serviceURL, "/"), storage.AzureBlob.Container,
+			storage.AzureBlob.Prefix, fileName)
+	case storage.GCS != nil:
+		return fmt.Sprintf("gs://%s/%s%s", storage.GCS.Bucket, storage.GCS.Prefix, fileName)
+	case storage.PersistentVolumeClaim != nil:
+		return fmt.Sprintf("pvc://%s/%s", b.Name, fileName)
+	case storage.Volume != nil && storage.Volume.NFS != nil:
+		return fmt.Sprintf("nfs://%s/%s", storage.Volume.NFS.Server,
+			strings.TrimPrefix(path.Join(storage.Volume.NFS.Path, fileName), "/"))
+	}
+	return ""
+}
+
 // +kubebuilder:object:root=true
 
 // BackupList contains a list of Backup
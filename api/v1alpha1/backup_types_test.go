@@ -51,6 +51,30 @@ var _ = Describe("Backup types", func() {
 					},
 				},
 			),
+			Entry(
+				"Binlog archive",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+					},
+				},
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+							Schedule: &Schedule{
+								Cron: "*/5 * * * *",
+							},
+						},
+						MaxRetention: metav1.Duration{Duration: 30 * 24 * time.Hour},
+						BackoffLimit: 5,
+					},
+				},
+			),
 		)
 		DescribeTable(
 			"Should return a volume",
@@ -130,5 +154,120 @@ var _ = Describe("Backup types", func() {
 				false,
 			),
 		)
+		DescribeTable(
+			"Should return a file location",
+			func(backup *Backup, expectedLocation string) {
+				Expect(backup.FileLocation("backup.2023-12-18T16:14:00Z.sql")).To(Equal(expectedLocation))
+			},
+			Entry(
+				"S3",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket: "backups",
+								Prefix: "mariadb/",
+							},
+						},
+					},
+				},
+				"s3://backups/mariadb/backup.2023-12-18T16:14:00Z.sql",
+			),
+			Entry(
+				"AzureBlob",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							AzureBlob: &AzureBlob{
+								Container:      "backups",
+								StorageAccount: "mariadb",
+							},
+						},
+					},
+				},
+				"https://mariadb.blob.core.windows.net/backups/backup.2023-12-18T16:14:00Z.sql",
+			),
+			Entry(
+				"GCS",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							GCS: &GCS{
+								Bucket: "backups",
+							},
+						},
+					},
+				},
+				"gs://backups/backup.2023-12-18T16:14:00Z.sql",
+			),
+			Entry(
+				"PVC",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimSpec{},
+						},
+					},
+				},
+				"pvc://backup-obj/backup.2023-12-18T16:14:00Z.sql",
+			),
+			Entry(
+				"NFS",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							Volume: &corev1.VolumeSource{
+								NFS: &corev1.NFSVolumeSource{
+									Server: "nfs.local",
+									Path:   "/exports/backups",
+								},
+							},
+						},
+					},
+				},
+				"nfs://nfs.local/exports/backups/backup.2023-12-18T16:14:00Z.sql",
+			),
+			Entry(
+				"Other volume",
+				&Backup{
+					ObjectMeta: objMeta,
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							Volume: &corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+				},
+				"",
+			),
+		)
+
+		DescribeTable(
+			"Should get verify SQL query",
+			func(verify *BackupVerify, expected string) {
+				Expect(verify.SqlQueryOrDefault()).To(Equal(expected))
+			},
+			Entry(
+				"Default",
+				&BackupVerify{
+					Enabled: true,
+				},
+				"SELECT COUNT(*) FROM information_schema.tables;",
+			),
+			Entry(
+				"Custom",
+				&BackupVerify{
+					Enabled:  true,
+					SqlQuery: "SELECT COUNT(*) FROM app.orders;",
+				},
+				"SELECT COUNT(*) FROM app.orders;",
+			),
+		)
 	})
 })
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -62,6 +63,7 @@ var _ = Describe("Backup webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
 							},
 							Volume: &corev1.VolumeSource{
 								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
@@ -98,6 +100,7 @@ var _ = Describe("Backup webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
 							},
 						},
 						MariaDBRef: MariaDBRef{
@@ -132,6 +135,7 @@ var _ = Describe("Backup webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
 							},
 						},
 						MariaDBRef: MariaDBRef{
@@ -151,6 +155,837 @@ var _ = Describe("Backup webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Invalid binlog archive schedule",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-binlog-archive",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+							Schedule: &Schedule{
+								Cron: "foo",
+							},
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid binlog archive with physical method",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-binlog-archive-physical",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method: BackupMethodPhysical,
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid binlog archive",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-binlog-archive",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid compression with physical method",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-compression-physical",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method:      BackupMethodPhysical,
+						Compression: CompressGzip,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid binlog archive with encryption",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-binlog-archive-encryption",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+						Encryption: &BackupEncryption{
+							KeySecretKeyRef: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "backup-encryption",
+								},
+								Key: "key",
+							},
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid AzureBlob without credentials",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-azure-blob",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							AzureBlob: &AzureBlob{
+								Container:      "test",
+								StorageAccount: "test",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid AzureBlob",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-azure-blob",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							AzureBlob: &AzureBlob{
+								Container:      "test",
+								StorageAccount: "test",
+								SASTokenSecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "azure-blob",
+									},
+									Key: "sas-token",
+								},
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid empty RetentionPolicy",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-retention-policy",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						RetentionPolicy: &BackupRetentionPolicy{},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid RetentionPolicy",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-retention-policy",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						RetentionPolicy: &BackupRetentionPolicy{
+							KeepDaily:   7,
+							KeepWeekly:  4,
+							KeepMonthly: 12,
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Valid GCS",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-gcs",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							GCS: &GCS{
+								Bucket: "test",
+								ServiceAccountKeySecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "gcs",
+									},
+									Key: "service-account.json",
+								},
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Physical with databases",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-physical-databases",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method:    BackupMethodPhysical,
+						Databases: []string{"db1"},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid tables",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-tables",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Tables: []string{"db1"},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid database name",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-database-name",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Databases: []string{"db1'; rm -rf /backup; echo '"},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid table name",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-invalid-table-name",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Tables: []string{"db1.$(id)"},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid databases and tables",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-databases",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Databases: []string{"db1", "db2"},
+						Tables:    []string{"db3.table1"},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Mydumper with Physical",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-mydumper-physical",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method: BackupMethodPhysical,
+						Engine: BackupEngineMydumper,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Mydumper with bzip2",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-mydumper-bzip2",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Engine:      BackupEngineMydumper,
+						Compression: CompressBzip2,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid Mydumper",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-mydumper",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Engine: BackupEngineMydumper,
+						Mydumper: &Mydumper{
+							Threads:       8,
+							Rows:          100000,
+							ChunkFilesize: 64,
+						},
+						Compression: CompressZstd,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"VolumeSnapshot with storage",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-volumesnapshot-storage",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method: BackupMethodVolumeSnapshot,
+						Target: BackupTargetPreferReplica,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"VolumeSnapshot with compression",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-volumesnapshot-compression",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method:      BackupMethodVolumeSnapshot,
+						Target:      BackupTargetPreferReplica,
+						Compression: CompressGzip,
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"VolumeSnapshot with Primary target",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-volumesnapshot-primary",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method: BackupMethodVolumeSnapshot,
+						Target: BackupTargetPrimary,
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid VolumeSnapshot",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-volumesnapshot",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method: BackupMethodVolumeSnapshot,
+						Target: BackupTargetPreferReplica,
+						VolumeSnapshot: &BackupVolumeSnapshot{
+							VolumeSnapshotClassName: ptr.To("csi-hostpath-snapclass"),
+						},
+						Schedule: &Schedule{
+							Cron: "0 */6 * * *",
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Streaming with physical method",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-streaming-physical",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Method: BackupMethodPhysical,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:    "test",
+								Endpoint:  "test",
+								IAM:       true,
+								Streaming: true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Streaming with databases",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-streaming-databases",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Databases: []string{"db1", "db2"},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:    "test",
+								Endpoint:  "test",
+								IAM:       true,
+								Streaming: true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Streaming with binlog archiving",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-streaming-binlog-archive",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:    "test",
+								Endpoint:  "test",
+								IAM:       true,
+								Streaming: true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid streaming",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-streaming",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Compression: CompressGzip,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:    "test",
+								Endpoint:  "test",
+								IAM:       true,
+								Streaming: true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Valid compression",
+				&Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backup-valid-compression",
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Compression: CompressZstd,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				false,
+			),
 			Entry(
 				"Valid",
 				&Backup{
@@ -166,6 +1001,52 @@ var _ = Describe("Backup webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								"cpu": resource.MustParse("100m"),
+							},
+						},
+						RestartPolicy: corev1.RestartPolicyOnFailure,
+					},
+				},
+				false,
+			),
+		)
+		DescribeTable(
+			"Should validate S3",
+			func(name string, s3Fn func(s3 *S3), wantErr bool) {
+				backup := &Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: testNamespace,
+					},
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "s3.amazonaws.com",
+								AccessKeyIdSecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "s3",
+									},
+									Key: "access-key-id",
+								},
+								SecretAccessKeySecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "s3",
+									},
+									Key: "secret-access-key",
+								},
 							},
 						},
 						MariaDBRef: MariaDBRef{
@@ -182,6 +1063,144 @@ var _ = Describe("Backup webhook", func() {
 						},
 						RestartPolicy: corev1.RestartPolicyOnFailure,
 					},
+				}
+				s3Fn(backup.Spec.Storage.S3)
+
+				err := k8sClient.Create(testCtx, backup)
+				if wantErr {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			},
+			Entry(
+				"Valid S3 access keys",
+				"backup-valid-s3-keys",
+				func(s3 *S3) {},
+				false,
+			),
+			Entry(
+				"Invalid S3 without secret access key",
+				"backup-invalid-s3-keys",
+				func(s3 *S3) {
+					s3.SecretAccessKeySecretKeyRef = nil
+				},
+				true,
+			),
+			Entry(
+				"Invalid S3 without credentials",
+				"backup-invalid-s3-credentials",
+				func(s3 *S3) {
+					s3.AccessKeyIdSecretKeyRef = nil
+					s3.SecretAccessKeySecretKeyRef = nil
+				},
+				true,
+			),
+			Entry(
+				"Invalid S3 IAM with access keys",
+				"backup-invalid-s3-iam-keys",
+				func(s3 *S3) {
+					s3.IAM = true
+				},
+				true,
+			),
+			Entry(
+				"Invalid S3 IAM with session token",
+				"backup-invalid-s3-session-token",
+				func(s3 *S3) {
+					s3.AccessKeyIdSecretKeyRef = nil
+					s3.SecretAccessKeySecretKeyRef = nil
+					s3.SessionTokenSecretKeyRef = &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "s3",
+						},
+						Key: "session-token",
+					}
+					s3.IAM = true
+				},
+				true,
+			),
+			Entry(
+				"Valid S3 IAM",
+				"backup-valid-s3-iam",
+				func(s3 *S3) {
+					s3.AccessKeyIdSecretKeyRef = nil
+					s3.SecretAccessKeySecretKeyRef = nil
+					s3.IAM = true
+				},
+				false,
+			),
+			Entry(
+				"Invalid S3 SSE-C without customer key",
+				"backup-invalid-s3-ssec-key",
+				func(s3 *S3) {
+					s3.TLS = &TLS{
+						Enabled: true,
+					}
+					s3.ServerSideEncryption = &S3ServerSideEncryption{
+						Type: S3SSETypeC,
+					}
+				},
+				true,
+			),
+			Entry(
+				"Invalid S3 SSE-C without TLS",
+				"backup-invalid-s3-ssec-tls",
+				func(s3 *S3) {
+					s3.ServerSideEncryption = &S3ServerSideEncryption{
+						Type: S3SSETypeC,
+						CustomerKeySecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "s3-sse",
+							},
+							Key: "customer-key",
+						},
+					}
+				},
+				true,
+			),
+			Entry(
+				"Invalid S3 SSE-S3 with KMS key",
+				"backup-invalid-s3-sse-kms-key",
+				func(s3 *S3) {
+					s3.ServerSideEncryption = &S3ServerSideEncryption{
+						Type:     S3SSETypeS3,
+						KMSKeyID: "key-id",
+					}
+				},
+				true,
+			),
+			Entry(
+				"Valid S3 SSE-C",
+				"backup-valid-s3-ssec",
+				func(s3 *S3) {
+					s3.TLS = &TLS{
+						Enabled: true,
+					}
+					s3.ServerSideEncryption = &S3ServerSideEncryption{
+						Type: S3SSETypeC,
+						CustomerKeySecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "s3-sse",
+							},
+							Key: "customer-key",
+						},
+					}
+				},
+				false,
+			),
+			Entry(
+				"Valid S3 SSE-KMS with storage class and tags",
+				"backup-valid-s3-sse-kms",
+				func(s3 *S3) {
+					s3.ServerSideEncryption = &S3ServerSideEncryption{
+						Type:     S3SSETypeKMS,
+						KMSKeyID: "key-id",
+					}
+					s3.StorageClass = "STANDARD_IA"
+					s3.Tags = map[string]string{
+						"team": "dba",
+					}
 				},
 				false,
 			),
@@ -205,6 +1224,7 @@ var _ = Describe("Backup webhook", func() {
 						S3: &S3{
 							Bucket:   "test",
 							Endpoint: "test",
+							IAM:      true,
 						},
 					},
 					MariaDBRef: MariaDBRef{
@@ -264,6 +1284,24 @@ var _ = Describe("Backup webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Initializing RetentionPolicy",
+				func(bmdb *Backup) {
+					bmdb.Spec.RetentionPolicy = &BackupRetentionPolicy{
+						KeepDaily: 7,
+					}
+				},
+				false,
+			),
+			Entry(
+				"Updating RetentionPolicy",
+				func(bmdb *Backup) {
+					bmdb.Spec.RetentionPolicy = &BackupRetentionPolicy{
+						KeepDaily: 14,
+					}
+				},
+				true,
+			),
 			Entry(
 				"Updating Storage",
 				func(bmdb *Backup) {
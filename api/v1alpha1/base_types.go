@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/mariadb-operator/mariadb-operator/pkg/webhook"
@@ -10,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 var (
@@ -19,8 +21,17 @@ var (
 	cronParser = cron.NewParser(
 		cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow,
 	)
+	// identifierRegex matches the database and table names that can be backed up and restored. They are used as part of
+	// the backup file names and the backup and restore scripts, so only a conservative subset of the MariaDB identifiers
+	// is allowed.
+	identifierRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_-]{0,63}$`)
 )
 
+// IsValidIdentifier determines whether a database or table name can be backed up and restored.
+func IsValidIdentifier(name string) bool {
+	return identifierRegex.MatchString(name)
+}
+
 // MariaDBRef is a reference to a MariaDB object.
 type MariaDBRef struct {
 	// ObjectReference is a reference to a object.
@@ -308,6 +319,22 @@ type SQLTemplate struct {
 	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
 }
 
+// CertIssuerRef is a reference to a cert-manager issuer.
+type CertIssuerRef struct {
+	// Name of the issuer.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Name string `json:"name"`
+	// Kind of the issuer. It defaults to Issuer, ClusterIssuer is also supported by cert-manager.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer. It defaults to cert-manager.io.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Group string `json:"group,omitempty"`
+}
+
 type TLS struct {
 	// Enabled is a flag to enable TLS.
 	// +optional
@@ -319,6 +346,49 @@ type TLS struct {
 	CASecretKeyRef *corev1.SecretKeySelector `json:"caSecretKeyRef,omitempty"`
 }
 
+// S3SSEType defines the type of server-side encryption applied by S3 to the stored objects.
+type S3SSEType string
+
+const (
+	// S3SSETypeS3 encrypts the objects with keys managed by S3.
+	S3SSETypeS3 S3SSEType = "SSE-S3"
+	// S3SSETypeKMS encrypts the objects with a key managed by AWS KMS.
+	S3SSETypeKMS S3SSEType = "SSE-KMS"
+	// S3SSETypeC encrypts the objects with a key provided by the customer, which is sent along with every request.
+	S3SSETypeC S3SSEType = "SSE-C"
+)
+
+// S3ServerSideEncryption defines the server-side encryption of the objects stored in S3.
+type S3ServerSideEncryption struct {
+	// Type is the type of server-side encryption.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=SSE-S3;SSE-KMS;SSE-C
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Type S3SSEType `json:"type"`
+	// KMSKeyID is the ID of the AWS KMS key used by SSE-KMS. It defaults to the AWS managed key for S3.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+	// CustomerKeySecretKeyRef is a reference to a Secret key containing the AES-256 key used by SSE-C.
+	// The key must be 32 bytes long, either raw or base64/hex encoded.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CustomerKeySecretKeyRef *corev1.SecretKeySelector `json:"customerKeySecretKeyRef,omitempty"`
+}
+
+func (s *S3ServerSideEncryption) Validate() error {
+	if s.KMSKeyID != "" && s.Type != S3SSETypeKMS {
+		return fmt.Errorf("kmsKeyId is only supported by %s", S3SSETypeKMS)
+	}
+	if s.Type == S3SSETypeC && s.CustomerKeySecretKeyRef == nil {
+		return fmt.Errorf("customerKeySecretKeyRef must be provided when using %s", S3SSETypeC)
+	}
+	if s.Type != S3SSETypeC && s.CustomerKeySecretKeyRef != nil {
+		return fmt.Errorf("customerKeySecretKeyRef is only supported by %s", S3SSETypeC)
+	}
+	return nil
+}
+
 type S3 struct {
 	// Bucket is the name Name of the bucket to store backups.
 	// +kubebuilder:validation:Required
@@ -337,48 +407,290 @@ type S3 struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Prefix string `json:"prefix" webhook:"inmutable"`
 	// AccessKeyIdSecretKeyRef is a reference to a Secret key containing the S3 access key id.
-	// +kubebuilder:validation:Required
+	// It is required unless IAM is enabled.
+	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	AccessKeyIdSecretKeyRef corev1.SecretKeySelector `json:"accessKeyIdSecretKeyRef"`
-	// AccessKeyIdSecretKeyRef is a reference to a Secret key containing the S3 secret key.
-	// +kubebuilder:validation:Required
+	AccessKeyIdSecretKeyRef *corev1.SecretKeySelector `json:"accessKeyIdSecretKeyRef,omitempty"`
+	// SecretAccessKeySecretKeyRef is a reference to a Secret key containing the S3 secret key.
+	// It must be provided along with AccessKeyIdSecretKeyRef.
+	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
-	SecretAccessKeySecretKeyRef corev1.SecretKeySelector `json:"secretAccessKeySecretKeyRef"`
+	SecretAccessKeySecretKeyRef *corev1.SecretKeySelector `json:"secretAccessKeySecretKeyRef,omitempty"`
 	// SessionTokenSecretKeyRef is a reference to a Secret key containing the S3 session token.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	SessionTokenSecretKeyRef *corev1.SecretKeySelector `json:"sessionTokenSecretKeyRef,omitempty"`
+	// IAM obtains the S3 credentials from the AWS IAM providers instead of from static keys, which allows authenticating via
+	// IRSA (web identity) by setting the ServiceAccountName of the Backup or Restore, or via the instance profile of the Node.
+	// It is not compatible with AccessKeyIdSecretKeyRef, SecretAccessKeySecretKeyRef and SessionTokenSecretKeyRef.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	IAM bool `json:"iam,omitempty"`
 	// TLS provides the configuration required to establish TLS connections with S3.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	TLS *TLS `json:"tls,omitempty"`
+	// Streaming pipes the backups directly into S3 multipart uploads, and restores them by streaming them back from S3,
+	// so they are not staged in a local volume. It is only supported by Logical Backups taken with mariadb-dump.
+	// Streaming Restores apply the backup before its digest is verified, therefore a Restore that fails the verification
+	// is marked as failed but it might have partially restored the database.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Streaming bool `json:"streaming,omitempty" webhook:"inmutable"`
+	// ServerSideEncryption defines the server-side encryption applied by S3 to the backup files.
+	// The same configuration is used to read the backup files, which is required by SSE-C.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServerSideEncryption *S3ServerSideEncryption `json:"serverSideEncryption,omitempty"`
+	// StorageClass is the S3 storage class of the backup files, for example STANDARD_IA or GLACIER_IR.
+	// It defaults to the storage class of the bucket.
+	// Storage classes that need the objects to be restored before reading them, such as GLACIER, are not supported by Restores.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	StorageClass string `json:"storageClass,omitempty"`
+	// Tags are the S3 object tags added to the backup files.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// IsStreaming determines whether the backups are streamed from and to S3.
+func (s *S3) IsStreaming() bool {
+	return s != nil && s.Streaming
+}
+
+// IsIAM determines whether the S3 credentials are obtained from the AWS IAM providers instead of from static keys.
+func (s *S3) IsIAM() bool {
+	return s != nil && s.IAM
+}
+
+func (s *S3) Validate() error {
+	if s.IsIAM() {
+		if s.AccessKeyIdSecretKeyRef != nil || s.SecretAccessKeySecretKeyRef != nil || s.SessionTokenSecretKeyRef != nil {
+			return errors.New("iam is not compatible with accessKeyIdSecretKeyRef, secretAccessKeySecretKeyRef and sessionTokenSecretKeyRef")
+		}
+	} else if s.AccessKeyIdSecretKeyRef == nil || s.SecretAccessKeySecretKeyRef == nil {
+		return errors.New("accessKeyIdSecretKeyRef and secretAccessKeySecretKeyRef must be provided unless iam is enabled")
+	}
+	if s.ServerSideEncryption != nil {
+		if err := s.ServerSideEncryption.Validate(); err != nil {
+			return fmt.Errorf("invalid serverSideEncryption: %v", err)
+		}
+		if s.ServerSideEncryption.Type == S3SSETypeC && (s.TLS == nil || !s.TLS.Enabled) {
+			return fmt.Errorf("%s requires TLS to be enabled", S3SSETypeC)
+		}
+	}
+	return nil
+}
+
+// AzureBlob defines the configuration to store backups in Azure Blob Storage.
+type AzureBlob struct {
+	// Container is the name of the Azure Blob Storage container to store backups.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Container string `json:"container" webhook:"inmutable"`
+	// StorageAccount is the name of the Azure storage account.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	StorageAccount string `json:"storageAccount" webhook:"inmutable"`
+	// ServiceURL is the Azure Blob Storage service URL. It defaults to 'https://<storageAccount>.blob.core.windows.net/'.
+	// It allows using the Azurite emulator, for example 'http://azurite:10000/devstoreaccount1'.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServiceURL string `json:"serviceURL,omitempty" webhook:"inmutable"`
+	// Prefix allows backups to be placed under a specific prefix in the container.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Prefix string `json:"prefix,omitempty" webhook:"inmutable"`
+	// AccountKeySecretKeyRef is a reference to a Secret key containing the storage account key.
+	// Either AccountKeySecretKeyRef or SASTokenSecretKeyRef must be provided.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AccountKeySecretKeyRef *corev1.SecretKeySelector `json:"accountKeySecretKeyRef,omitempty"`
+	// SASTokenSecretKeyRef is a reference to a Secret key containing a shared access signature (SAS) token
+	// with permissions to list, read, write and delete blobs in the container.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	SASTokenSecretKeyRef *corev1.SecretKeySelector `json:"sasTokenSecretKeyRef,omitempty"`
+}
+
+func (a *AzureBlob) Validate() error {
+	if (a.AccountKeySecretKeyRef == nil) == (a.SASTokenSecretKeyRef == nil) {
+		return errors.New("either accountKeySecretKeyRef or sasTokenSecretKeyRef must be provided")
+	}
+	return nil
+}
+
+// GCS defines the configuration to store backups in Google Cloud Storage.
+type GCS struct {
+	// Bucket is the name of the GCS bucket to store backups.
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Bucket string `json:"bucket" webhook:"inmutable"`
+	// Prefix allows backups to be placed under a specific prefix in the bucket.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Prefix string `json:"prefix,omitempty" webhook:"inmutable"`
+	// ServiceAccountKeySecretKeyRef is a reference to a Secret key containing a Google service account JSON key.
+	// When not provided, the Application Default Credentials are used, which allows authenticating via workload identity
+	// by setting the ServiceAccountName of the Backup or Restore.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServiceAccountKeySecretKeyRef *corev1.SecretKeySelector `json:"serviceAccountKeySecretKeyRef,omitempty"`
 }
 
 // RestoreSource defines a source for restoring a MariaDB.
 type RestoreSource struct {
-	// BackupRef is a reference to a Backup object. It has priority over S3 and Volume.
+	// BackupRef is a reference to a Backup object. It has priority over S3, AzureBlob, GCS and Volume.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	BackupRef *corev1.LocalObjectReference `json:"backupRef,omitempty" webhook:"inmutableinit"`
-	// S3 defines the configuration to restore backups from a S3 compatible storage. It has priority over Volume.
+	// S3 defines the configuration to restore backups from a S3 compatible storage.
+	// It has priority over AzureBlob, GCS and Volume.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	S3 *S3 `json:"s3,omitempty" webhook:"inmutableinit"`
+	// AzureBlob defines the configuration to restore backups from Azure Blob Storage. It has priority over GCS and Volume.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	AzureBlob *AzureBlob `json:"azureBlob,omitempty" webhook:"inmutableinit"`
+	// GCS defines the configuration to restore backups from Google Cloud Storage. It has priority over Volume.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	GCS *GCS `json:"gcs,omitempty" webhook:"inmutableinit"`
 	// Volume is a Kubernetes Volume object that contains a backup.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Volume *corev1.VolumeSource `json:"volume,omitempty" webhook:"inmutableinit"`
+	// VolumeSnapshotRef is a reference to a CSI VolumeSnapshot, used as data source of the storage PVCs.
+	// It is only supported when bootstrapping a new MariaDB.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	VolumeSnapshotRef *corev1.LocalObjectReference `json:"volumeSnapshotRef,omitempty" webhook:"inmutableinit"`
+	// Method used to take the Backup to be restored. Physical Backups are restored by copying the datadir back,
+	// which is only supported when bootstrapping a new MariaDB. When bootstrapping a Galera cluster, the datadir is only
+	// restored in the first Pod, and the rest of the Pods join the cluster via a full SST.
+	// The VolumeSnapshot method is implied by the VolumeSnapshotRef.
+	// It is defaulted from the BackupRef when provided, otherwise a Logical Backup is assumed.
+	// +optional
+	// +kubebuilder:validation:Enum=Logical;Physical;VolumeSnapshot
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Method BackupMethod `json:"method,omitempty" webhook:"inmutableinit"`
+	// Engine used to take the Logical Backup to be restored. Backups taken with Mydumper are loaded in parallel by myloader.
+	// It is defaulted from the BackupRef when provided, otherwise a Backup taken with mariadb-dump is assumed.
+	// +optional
+	// +kubebuilder:validation:Enum=MariadbDump;Mydumper
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Engine BackupEngine `json:"engine,omitempty" webhook:"inmutableinit"`
+	// ReplayBinlogs indicates whether the archived binary logs should be replayed on top of the Backup up to the TargetRecoveryTime.
+	// It is defaulted from the BackupRef when provided, unless a single Database is restored. It is only supported by Logical Backups.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	ReplayBinlogs *bool `json:"replayBinlogs,omitempty" webhook:"inmutableinit"`
+	// Encryption defines how to decrypt the Backup files. Unencrypted Backup files are restored as is.
+	// It is defaulted from the BackupRef when provided.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Encryption *BackupEncryption `json:"encryption,omitempty" webhook:"inmutableinit"`
 	// TargetRecoveryTime is a RFC3339 (1970-01-01T00:00:00Z) date and time that defines the point in time recovery objective.
 	// It is used to determine the closest restoration source in time.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	TargetRecoveryTime *metav1.Time `json:"targetRecoveryTime,omitempty" webhook:"inmutable"`
+	// Database to be restored, either from a backup file of that database or by extracting it from a backup file containing
+	// all the databases. The rest of databases are left untouched. All the databases are restored when not provided.
+	// Database names may only contain letters, digits, '_' and '-'. It is only supported by Logical Backups.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Database string `json:"database,omitempty" webhook:"inmutableinit"`
+	// TargetDatabase is the name of the database where the Database is restored into. It defaults to the Database name.
+	// Only the CREATE DATABASE and USE statements of the dump are renamed, therefore views, triggers, routines and events
+	// referencing objects qualified with the original database name keep referencing the original database.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TargetDatabase string `json:"targetDatabase,omitempty" webhook:"inmutableinit"`
 }
 
 func (r *RestoreSource) Validate() error {
-	if r.BackupRef == nil && r.S3 == nil && r.Volume == nil {
+	if r.IsVolumeSnapshot() {
+		return r.validateVolumeSnapshot()
+	}
+	if r.BackupRef == nil && r.S3 == nil && r.AzureBlob == nil && r.GCS == nil && r.Volume == nil {
 		return errors.New("unable to determine restore source")
 	}
+	objectStorages := 0
+	for _, isSet := range []bool{r.S3 != nil, r.AzureBlob != nil, r.GCS != nil} {
+		if isSet {
+			objectStorages++
+		}
+	}
+	if objectStorages > 1 {
+		return errors.New("at most one object storage (S3, AzureBlob or GCS) should be provided")
+	}
+	if r.S3 != nil {
+		if err := r.S3.Validate(); err != nil {
+			return fmt.Errorf("invalid S3: %v", err)
+		}
+	}
+	if r.AzureBlob != nil {
+		if err := r.AzureBlob.Validate(); err != nil {
+			return fmt.Errorf("invalid AzureBlob: %v", err)
+		}
+	}
+	if r.IsPhysical() && r.IsReplayBinlogsEnabled() {
+		return errors.New("replaying binlogs is only supported by Logical Backups")
+	}
+	if r.IsPhysical() && r.IsMydumperEngine() {
+		return errors.New("the Mydumper engine is only supported by Logical Backups")
+	}
+	if r.Database != "" {
+		if r.IsPhysical() {
+			return errors.New("restoring a single database is only supported by Logical Backups")
+		}
+		if r.IsReplayBinlogsEnabled() {
+			return errors.New("restoring a single database is not supported along with replaying binlogs")
+		}
+	}
+	if r.TargetDatabase != "" && r.Database == "" {
+		return errors.New("targetDatabase requires a database to be restored")
+	}
+	if r.IsStreaming() {
+		if err := r.validateStreaming(); err != nil {
+			return err
+		}
+	}
+	for _, database := range []string{r.Database, r.TargetDatabase} {
+		if database != "" && !IsValidIdentifier(database) {
+			return fmt.Errorf("invalid database name '%s'", database)
+		}
+	}
+	return nil
+}
+
+func (r *RestoreSource) validateVolumeSnapshot() error {
+	if r.BackupRef != nil || r.S3 != nil || r.AzureBlob != nil || r.GCS != nil || r.Volume != nil {
+		return errors.New("volumeSnapshotRef is not compatible with other restore sources")
+	}
+	if r.Method != "" && r.Method != BackupMethodVolumeSnapshot {
+		return fmt.Errorf("method '%s' is not compatible with volumeSnapshotRef", r.Method)
+	}
+	if r.IsReplayBinlogsEnabled() || r.IsEncrypted() || r.TargetRecoveryTime != nil || r.Database != "" {
+		return errors.New("volumeSnapshotRef does not support replaying binlogs, encryption, targetRecoveryTime nor database")
+	}
+	return nil
+}
+
+func (r *RestoreSource) validateStreaming() error {
+	if r.IsPhysical() || r.IsMydumperEngine() {
+		return errors.New("streaming is only supported by Logical Backups taken with mariadb-dump")
+	}
+	if r.IsEncrypted() {
+		return errors.New("streaming is not supported by encrypted Backups")
+	}
+	if r.IsReplayBinlogsEnabled() {
+		return errors.New("streaming is not supported along with replaying binlogs")
+	}
 	return nil
 }
 
@@ -386,8 +698,35 @@ func (r *RestoreSource) IsDefaulted() bool {
 	return r.Volume != nil
 }
 
+func (r *RestoreSource) IsPhysical() bool {
+	return r.Method == BackupMethodPhysical
+}
+
+// IsMydumperEngine determines whether the Backup to be restored was taken with mydumper.
+func (r *RestoreSource) IsMydumperEngine() bool {
+	return r.Engine == BackupEngineMydumper
+}
+
+// IsVolumeSnapshot determines whether the storage PVCs are provisioned from a VolumeSnapshot.
+func (r *RestoreSource) IsVolumeSnapshot() bool {
+	return r.VolumeSnapshotRef != nil
+}
+
+// IsStreaming determines whether the backup is streamed from S3 when restoring.
+func (r *RestoreSource) IsStreaming() bool {
+	return r.S3.IsStreaming()
+}
+
+func (r *RestoreSource) IsReplayBinlogsEnabled() bool {
+	return r.ReplayBinlogs != nil && *r.ReplayBinlogs
+}
+
+func (r *RestoreSource) IsEncrypted() bool {
+	return r.Encryption != nil
+}
+
 func (r *RestoreSource) SetDefaults() {
-	if r.S3 != nil {
+	if r.S3 != nil || r.AzureBlob != nil || r.GCS != nil {
 		r.Volume = &corev1.VolumeSource{
 			EmptyDir: &corev1.EmptyDirVolumeSource{},
 		}
@@ -395,12 +734,26 @@ func (r *RestoreSource) SetDefaults() {
 }
 
 func (r *RestoreSource) SetDefaultsWithBackup(backup *Backup) error {
+	if backup.IsVolumeSnapshot() {
+		return errors.New("VolumeSnapshot Backups must be restored using volumeSnapshotRef")
+	}
 	volume, err := backup.Volume()
 	if err != nil {
 		return fmt.Errorf("error getting backup volume: %v", err)
 	}
 	r.Volume = volume
 	r.S3 = backup.Spec.Storage.S3
+	r.AzureBlob = backup.Spec.Storage.AzureBlob
+	r.GCS = backup.Spec.Storage.GCS
+	r.Method = backup.Spec.Method
+	r.Engine = backup.Spec.Engine
+	// Binlogs contain the changes of all the databases, so they are not replayed when restoring a single database.
+	if r.ReplayBinlogs == nil && backup.IsBinlogArchiveEnabled() && r.Database == "" {
+		r.ReplayBinlogs = ptr.To(true)
+	}
+	if r.Encryption == nil {
+		r.Encryption = backup.Spec.Encryption
+	}
 	return nil
 }
 
@@ -428,3 +781,12 @@ func (s *Schedule) Validate() error {
 	_, err := cronParser.Parse(s.Cron)
 	return err
 }
+
+// Next returns the next time the schedule is activated after the given time.
+func (s *Schedule) Next(t time.Time) (time.Time, error) {
+	schedule, err := cronParser.Parse(s.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(t), nil
+}
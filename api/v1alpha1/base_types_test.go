@@ -4,6 +4,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 var _ = Describe("Base types", func() {
@@ -107,6 +108,58 @@ var _ = Describe("Base types", func() {
 				true,
 				false,
 			),
+			Entry(
+				"AzureBlob priority over Volume",
+				&RestoreSource{
+					AzureBlob: &AzureBlob{
+						Container:      "test",
+						StorageAccount: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						NFS: &corev1.NFSVolumeSource{
+							Server: "test",
+							Path:   "test",
+						},
+					},
+				},
+				nil,
+				&RestoreSource{
+					AzureBlob: &AzureBlob{
+						Container:      "test",
+						StorageAccount: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
+			Entry(
+				"GCS priority over Volume",
+				&RestoreSource{
+					GCS: &GCS{
+						Bucket: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						NFS: &corev1.NFSVolumeSource{
+							Server: "test",
+							Path:   "test",
+						},
+					},
+				},
+				nil,
+				&RestoreSource{
+					GCS: &GCS{
+						Bucket: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
 			Entry(
 				"Backup S3",
 				&RestoreSource{},
@@ -158,6 +211,196 @@ var _ = Describe("Base types", func() {
 				true,
 				false,
 			),
+			Entry(
+				"Backup physical",
+				&RestoreSource{},
+				&Backup{
+					Spec: BackupSpec{
+						Method: BackupMethodPhysical,
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+							},
+						},
+					},
+				},
+				&RestoreSource{
+					Method: BackupMethodPhysical,
+					S3: &S3{
+						Bucket:   "test",
+						Endpoint: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
+			Entry(
+				"Backup VolumeSnapshot",
+				&RestoreSource{},
+				&Backup{
+					Spec: BackupSpec{
+						Method: BackupMethodVolumeSnapshot,
+					},
+				},
+				&RestoreSource{},
+				false,
+				true,
+			),
+			Entry(
+				"Backup binlog archive",
+				&RestoreSource{},
+				&Backup{
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+							},
+						},
+					},
+				},
+				&RestoreSource{
+					ReplayBinlogs: ptr.To(true),
+					S3: &S3{
+						Bucket:   "test",
+						Endpoint: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
+			Entry(
+				"Backup binlog archive with database",
+				&RestoreSource{
+					Database: "db1",
+				},
+				&Backup{
+					Spec: BackupSpec{
+						BinlogArchive: &BinlogArchive{
+							Enabled: true,
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+							},
+						},
+					},
+				},
+				&RestoreSource{
+					Database: "db1",
+					S3: &S3{
+						Bucket:   "test",
+						Endpoint: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
+			Entry(
+				"Backup encryption",
+				&RestoreSource{},
+				&Backup{
+					Spec: BackupSpec{
+						Encryption: &BackupEncryption{
+							KeySecretKeyRef: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "backup-encryption",
+								},
+								Key: "key",
+							},
+						},
+						Storage: BackupStorage{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+							},
+						},
+					},
+				},
+				&RestoreSource{
+					Encryption: &BackupEncryption{
+						KeySecretKeyRef: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "backup-encryption",
+							},
+							Key: "key",
+						},
+					},
+					S3: &S3{
+						Bucket:   "test",
+						Endpoint: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
+			Entry(
+				"Backup AzureBlob",
+				&RestoreSource{},
+				&Backup{
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							AzureBlob: &AzureBlob{
+								Container:      "test",
+								StorageAccount: "test",
+							},
+						},
+					},
+				},
+				&RestoreSource{
+					AzureBlob: &AzureBlob{
+						Container:      "test",
+						StorageAccount: "test",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
+			Entry(
+				"Backup GCS",
+				&RestoreSource{},
+				&Backup{
+					Spec: BackupSpec{
+						Storage: BackupStorage{
+							GCS: &GCS{
+								Bucket: "test",
+								Prefix: "mariadb",
+							},
+						},
+					},
+				},
+				&RestoreSource{
+					GCS: &GCS{
+						Bucket: "test",
+						Prefix: "mariadb",
+					},
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				true,
+				false,
+			),
 			Entry(
 				"Backup priority over S3",
 				&RestoreSource{
@@ -9,6 +9,10 @@ const (
 	// ConditionTypeGaleraConfigured indicates that the cluster has been successfully configured.
 	ConditionTypeGaleraConfigured string = "GaleraConfigured"
 	ConditionTypeComplete         string = "Complete"
+	// ConditionTypeTLSCertificatesExpiring indicates that some of the TLS certificates are close to expiry.
+	ConditionTypeTLSCertificatesExpiring string = "TLSCertificatesExpiring"
+	// ConditionTypeVerified indicates that the last Backup has been restored and verified successfully.
+	ConditionTypeVerified string = "Verified"
 
 	ConditionReasonStatefulSetNotReady string = "StatefulSetNotReady"
 	ConditionReasonStatefulSetReady    string = "StatefulSetReady"
@@ -18,6 +22,9 @@ const (
 	ConditionReasonGaleraNotReady      string = "GaleraNotReady"
 	ConditionReasonGaleraConfigured    string = "GaleraConfigured"
 
+	ConditionReasonTLSCertificatesExpiring string = "TLSCertificatesExpiring"
+	ConditionReasonTLSCertificatesValid    string = "TLSCertificatesValid"
+
 	ConditionReasonMaxScaleNotReady string = "MaxScaleNotReady"
 	ConditionReasonMaxScaleReady    string = "MaxScaleReady"
 
@@ -36,6 +43,10 @@ const (
 
 	ConditionReasonConnectionFailed string = "ConnectionFailed"
 
+	ConditionReasonVolumeSnapshotScheduled string = "VolumeSnapshotScheduled"
+	ConditionReasonVolumeSnapshotNotReady  string = "VolumeSnapshotNotReady"
+	ConditionReasonVolumeSnapshotReady     string = "VolumeSnapshotReady"
+
 	ConditionReasonCreated string = "Created"
 	ConditionReasonHealthy string = "Healthy"
 	ConditionReasonFailed  string = "Failed"
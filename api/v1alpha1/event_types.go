@@ -43,6 +43,18 @@ const (
 	// ReasonMaxScalePrimaryServerChanged indicates that the primary server managed by MaxScale has changed.
 	ReasonMaxScalePrimaryServerChanged = "MaxScalePrimaryServerChanged"
 
+	// ReasonTLSCertificateRenewed indicates that a TLS certificate has been renewed.
+	ReasonTLSCertificateRenewed = "TLSCertificateRenewed"
+	// ReasonTLSCertificateReloaded indicates that a Pod has reloaded its TLS certificate.
+	ReasonTLSCertificateReloaded = "TLSCertificateReloaded"
+	// ReasonTLSCertificateRestart indicates that a Pod is being restarted to load its TLS certificate.
+	ReasonTLSCertificateRestart = "TLSCertificateRestart"
+
+	// ReasonBackupVerifyFailed indicates that a Backup could not be restored and verified.
+	ReasonBackupVerifyFailed = "BackupVerifyFailed"
+	// ReasonBackupCatalogInvalid indicates that the catalog reported by the backup Job could not be read.
+	ReasonBackupCatalogInvalid = "BackupCatalogInvalid"
+
 	// ReasonWebhookUpdateFailed indicates that the webhook configuration update failed.
 	ReasonWebhookUpdateFailed = "WebhookUpdateFailed"
 
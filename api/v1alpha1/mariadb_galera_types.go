@@ -202,6 +202,15 @@ func (g *GaleraRecovery) FillWithDefaults() {
 	}
 }
 
+// GaleraTLS defines the TLS configuration for the Galera replication and SST traffic.
+type GaleraTLS struct {
+	// Enabled is a flag to encrypt the Galera replication and SST traffic using the MariaDB TLS certificates.
+	// It requires TLS to be enabled in the MariaDB.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Enabled bool `json:"enabled,omitempty"`
+}
+
 // Galera allows you to enable multi-master HA via Galera in your MariaDB cluster.
 type Galera struct {
 	// GaleraSpec is the Galera desired state specification.
@@ -231,6 +240,10 @@ type GaleraSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	ReplicaThreads *int `json:"replicaThreads,omitempty"`
+	// TLS defines the TLS configuration for the Galera replication and SST traffic.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TLS *GaleraTLS `json:"tls,omitempty"`
 	// GaleraAgent is a sidecar agent that co-operates with mariadb-operator.
 	// More info: https://github.com/mariadb-operator/agent.
 	// +optional
@@ -359,6 +372,12 @@ type GaleraRecoveryStatus struct {
 	Bootstrap *GaleraRecoveryBootstrap `json:"bootstrap,omitempty"`
 }
 
+// IsGaleraTLSEnabled indicates whether the Galera replication and SST traffic is encrypted.
+func (m *MariaDB) IsGaleraTLSEnabled() bool {
+	galera := m.Galera()
+	return galera.Enabled && galera.TLS != nil && galera.TLS.Enabled && m.IsTLSEnabled()
+}
+
 // HasGaleraReadyCondition indicates whether the MariaDB object has a GaleraReady status condition.
 // This means that the Galera cluster is healthy.
 func (m *MariaDB) HasGaleraReadyCondition() bool {
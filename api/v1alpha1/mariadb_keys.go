@@ -45,6 +45,14 @@ func (m *MariaDB) RestoreKey() types.NamespacedName {
 	}
 }
 
+// StoragePVCKey defines the key for the storage PVC of the Pod with the given index
+func (m *MariaDB) StoragePVCKey(podIndex int) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("storage-%s-%d", m.Name, podIndex),
+		Namespace: m.Namespace,
+	}
+}
+
 // InternalServiceKey defines the key for the internal headless Service
 func (m *MariaDB) InternalServiceKey() types.NamespacedName {
 	return types.NamespacedName{
@@ -130,3 +138,58 @@ func (m *MariaDB) ReplConfigMapKeyRef() corev1.ConfigMapKeySelector {
 		Key: "replication.sh",
 	}
 }
+
+// GaleraConfigMapKeyRef defines the key selector for the Galera configuration ConfigMap.
+func (m *MariaDB) GaleraConfigMapKeyRef() corev1.ConfigMapKeySelector {
+	return corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: fmt.Sprintf("%s-galera", m.Name),
+		},
+		Key: "tls.cnf",
+	}
+}
+
+// TLSCASecretKey defines the key for the CA Secret issued by the operator.
+func (m *MariaDB) TLSCASecretKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-ca", m.Name),
+		Namespace: m.Namespace,
+	}
+}
+
+// TLSServerCertSecretKey defines the key for the server certificate Secret issued by the operator or by cert-manager.
+func (m *MariaDB) TLSServerCertSecretKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-server-cert", m.Name),
+		Namespace: m.Namespace,
+	}
+}
+
+// TLSServerCASecretKeyRef defines the key selector for the CA certificate used by the server and clients.
+func (m *MariaDB) TLSServerCASecretKeyRef() corev1.SecretKeySelector {
+	if m.Spec.TLS != nil && m.Spec.TLS.ServerCASecretKeyRef != nil {
+		return *m.Spec.TLS.ServerCASecretKeyRef
+	}
+	if m.Spec.TLS != nil && m.Spec.TLS.IssuerRef != nil {
+		return corev1.SecretKeySelector{
+			LocalObjectReference: m.TLSServerCertSecretRef(),
+			Key:                  "ca.crt",
+		}
+	}
+	return corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: m.TLSCASecretKey().Name,
+		},
+		Key: corev1.TLSCertKey,
+	}
+}
+
+// TLSServerCertSecretRef defines the reference to the TLS Secret containing the server certificate.
+func (m *MariaDB) TLSServerCertSecretRef() corev1.LocalObjectReference {
+	if m.Spec.TLS != nil && m.Spec.TLS.ServerCertSecretRef != nil {
+		return *m.Spec.TLS.ServerCertSecretRef
+	}
+	return corev1.LocalObjectReference{
+		Name: m.TLSServerCertSecretKey().Name,
+	}
+}
@@ -91,6 +91,60 @@ type Metrics struct {
 	PasswordSecretKeyRef corev1.SecretKeySelector `json:"passwordSecretKeyRef,omitempty" webhook:"inmutableinit"`
 }
 
+// MariaDBTLS defines the TLS configuration for MariaDB.
+type MariaDBTLS struct {
+	// Enabled is a flag to enable TLS.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Enabled bool `json:"enabled,omitempty"`
+	// RequireSecureTransport indicates whether TCP connections must use TLS.
+	// When enabled, the server rejects insecure connections by setting 'require_secure_transport'.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	RequireSecureTransport bool `json:"requireSecureTransport,omitempty"`
+	// ServerCASecretKeyRef is a reference to a Secret key containing the CA certificate used to issue the server certificate.
+	// If not provided, a CA is issued by the operator.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServerCASecretKeyRef *corev1.SecretKeySelector `json:"serverCASecretKeyRef,omitempty"`
+	// ServerCertSecretRef is a reference to a TLS Secret containing the server certificate and private key.
+	// The Secret must contain the 'tls.crt' and 'tls.key' keys. If not provided, a certificate is issued by the operator.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServerCertSecretRef *corev1.LocalObjectReference `json:"serverCertSecretRef,omitempty"`
+	// IssuerRef is a reference to a cert-manager issuer used to issue the server certificate.
+	// When provided, a cert-manager Certificate is created instead of issuing the certificates with the operator.
+	// The CA is read from the 'ca.crt' key of the server certificate Secret, unless ServerCASecretKeyRef is provided.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	IssuerRef *CertIssuerRef `json:"issuerRef,omitempty"`
+	// CAValidity determines the validity of the CA issued by the operator. It defaults to 4 years.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CAValidity *metav1.Duration `json:"caValidity,omitempty"`
+	// CertValidity determines the validity of the server certificate issued by the operator or by cert-manager. It defaults to 1 year.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CertValidity *metav1.Duration `json:"certValidity,omitempty"`
+	// LookaheadValidity is the time window before expiration in which certificates are considered close to expiry.
+	// Certificates issued by the operator or by cert-manager are automatically renewed within this window. It defaults to 90 days.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	LookaheadValidity *metav1.Duration `json:"lookaheadValidity,omitempty"`
+}
+
+// MariaDBTLSStatus aggregates the status of the certificates used by MariaDB.
+type MariaDBTLSStatus struct {
+	// CACertNotAfter indicates the expiration time of the CA certificate.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	CACertNotAfter *metav1.Time `json:"caCertNotAfter,omitempty"`
+	// ServerCertNotAfter indicates the expiration time of the server certificate.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	ServerCertNotAfter *metav1.Time `json:"serverCertNotAfter,omitempty"`
+}
+
 // MariaDBMaxScaleSpec defines a MaxScale resources to be used with the current MariaDB.
 type MariaDBMaxScaleSpec struct {
 	// Enabled is a flag to enable a MaxScale instance to be used with the current MariaDB.
@@ -131,6 +185,10 @@ type MariaDBMaxScaleSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Admin *MaxScaleAdmin `json:"admin,omitempty"`
+	// TLS defines the TLS configuration for MaxScale.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TLS *MaxScaleTLS `json:"tls,omitempty"`
 	// Config defines the MaxScale configuration.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -234,6 +292,10 @@ type MariaDBSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Metrics *Metrics `json:"metrics,omitempty"`
+	// TLS defines the TLS configuration for the server and client connections.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TLS *MariaDBTLS `json:"tls,omitempty"`
 	// Replication configures high availability via replication.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -328,6 +390,10 @@ type MariaDBStatus struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	ReplicationStatus ReplicationStatus `json:"replicationStatus,omitempty"`
+	// TLS is the status of the certificates used by MariaDB.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	TLS *MariaDBTLSStatus `json:"tls,omitempty"`
 }
 
 // SetCondition sets a status condition to MariaDB
@@ -447,6 +513,32 @@ func (m *MariaDB) AreMetricsEnabled() bool {
 	return m.Spec.Metrics != nil && m.Spec.Metrics.Enabled
 }
 
+// IsTLSEnabled indicates whether the MariaDB instance has TLS enabled
+func (m *MariaDB) IsTLSEnabled() bool {
+	return m.Spec.TLS != nil && m.Spec.TLS.Enabled
+}
+
+// IsSecureTransportRequired indicates whether the MariaDB instance requires TLS for TCP connections
+func (m *MariaDB) IsSecureTransportRequired() bool {
+	return m.IsTLSEnabled() && m.Spec.TLS.RequireSecureTransport
+}
+
+// IsTLSIssuedByOperator indicates whether the TLS certificates are issued by the operator
+func (m *MariaDB) IsTLSIssuedByOperator() bool {
+	return m.IsTLSEnabled() && m.Spec.TLS.IssuerRef == nil &&
+		m.Spec.TLS.ServerCASecretKeyRef == nil && m.Spec.TLS.ServerCertSecretRef == nil
+}
+
+// IsTLSIssuedByCertManager indicates whether the TLS certificates are issued by cert-manager
+func (m *MariaDB) IsTLSIssuedByCertManager() bool {
+	return m.IsTLSEnabled() && m.Spec.TLS.IssuerRef != nil
+}
+
+// HasTLSCertificatesExpiring indicates whether the MariaDB instance has TLS certificates close to expiry
+func (m *MariaDB) HasTLSCertificatesExpiring() bool {
+	return meta.IsStatusConditionTrue(m.Status.Conditions, ConditionTypeTLSCertificatesExpiring)
+}
+
 // IsInitialDataEnabled indicates whether the MariaDB instance has initial data enabled
 func (m *MariaDB) IsInitialDataEnabled() bool {
 	return m.Spec.Username != nil
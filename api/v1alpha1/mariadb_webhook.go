@@ -53,6 +53,7 @@ func (r *MariaDB) ValidateCreate() (admission.Warnings, error) {
 		r.validateStorage,
 		r.validateRootPassword,
 		r.validateMaxScale,
+		r.validateTLS,
 	}
 	for _, fn := range validateFns {
 		if err := fn(); err != nil {
@@ -77,6 +78,7 @@ func (r *MariaDB) ValidateUpdate(old runtime.Object) (admission.Warnings, error)
 		r.validatePodDisruptionBudget,
 		r.validateStorage,
 		r.validateRootPassword,
+		r.validateTLS,
 	}
 	for _, fn := range validateFns {
 		if err := fn(); err != nil {
@@ -148,6 +150,13 @@ func (r *MariaDB) validateGalera() error {
 			"'spec.galera.replicaThreads' must be at least 1",
 		)
 	}
+	if r.Galera().TLS != nil && r.Galera().TLS.Enabled && !r.IsTLSEnabled() {
+		return field.Invalid(
+			field.NewPath("spec").Child("galera").Child("tls"),
+			r.Galera().TLS,
+			"'spec.galera.tls' requires 'spec.tls' to be enabled",
+		)
+	}
 	return nil
 }
 
@@ -204,6 +213,38 @@ func (r *MariaDB) validateBootstrapFrom() error {
 			err.Error(),
 		)
 	}
+	if r.Spec.BootstrapFrom.IsPhysical() {
+		if r.IsEphemeralStorageEnabled() {
+			return field.Invalid(
+				field.NewPath("spec").Child("bootstrapFrom").Child("method"),
+				r.Spec.BootstrapFrom.Method,
+				"Physical bootstrap is not supported with 'spec.ephemeralStorage'",
+			)
+		}
+		if r.Replication().Enabled {
+			return field.Invalid(
+				field.NewPath("spec").Child("bootstrapFrom").Child("method"),
+				r.Spec.BootstrapFrom.Method,
+				"Physical bootstrap is not supported with 'spec.replication'",
+			)
+		}
+	}
+	if r.Spec.BootstrapFrom.IsVolumeSnapshot() {
+		if r.IsEphemeralStorageEnabled() {
+			return field.Invalid(
+				field.NewPath("spec").Child("bootstrapFrom").Child("volumeSnapshotRef"),
+				r.Spec.BootstrapFrom.VolumeSnapshotRef,
+				"VolumeSnapshot bootstrap is not supported with 'spec.ephemeralStorage'",
+			)
+		}
+		if r.Replication().Enabled {
+			return field.Invalid(
+				field.NewPath("spec").Child("bootstrapFrom").Child("volumeSnapshotRef"),
+				r.Spec.BootstrapFrom.VolumeSnapshotRef,
+				"VolumeSnapshot bootstrap is not supported with 'spec.replication'",
+			)
+		}
+	}
 	return nil
 }
 
@@ -252,3 +293,33 @@ func (r *MariaDB) validateRootPassword() error {
 	}
 	return nil
 }
+
+func (r *MariaDB) validateTLS() error {
+	if !r.IsTLSEnabled() {
+		return nil
+	}
+	if r.Spec.TLS.IssuerRef != nil {
+		if r.Spec.TLS.ServerCertSecretRef != nil {
+			return field.Invalid(
+				field.NewPath("spec").Child("tls").Child("issuerRef"),
+				r.Spec.TLS.IssuerRef,
+				"'spec.tls.issuerRef' and 'spec.tls.serverCertSecretRef' cannot be specified simultaneously",
+			)
+		}
+	} else if (r.Spec.TLS.ServerCASecretKeyRef == nil) != (r.Spec.TLS.ServerCertSecretRef == nil) {
+		return field.Invalid(
+			field.NewPath("spec").Child("tls"),
+			r.Spec.TLS,
+			"'spec.tls.serverCASecretKeyRef' and 'spec.tls.serverCertSecretRef' must be provided together",
+		)
+	}
+	if r.Spec.TLS.CertValidity != nil && r.Spec.TLS.LookaheadValidity != nil &&
+		r.Spec.TLS.LookaheadValidity.Duration >= r.Spec.TLS.CertValidity.Duration {
+		return field.Invalid(
+			field.NewPath("spec").Child("tls").Child("lookaheadValidity"),
+			r.Spec.TLS.LookaheadValidity,
+			"'spec.tls.lookaheadValidity' must be lower than 'spec.tls.certValidity'",
+		)
+	}
+	return nil
+}
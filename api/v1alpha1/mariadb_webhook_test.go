@@ -70,6 +70,107 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Valid physical BootstrapFrom",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						VolumeClaimTemplate: VolumeClaimTemplate{
+							PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										"storage": resource.MustParse("100Mi"),
+									},
+								},
+								AccessModes: []corev1.PersistentVolumeAccessMode{
+									corev1.ReadWriteOnce,
+								},
+							},
+						},
+						BootstrapFrom: &RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							Method: BackupMethodPhysical,
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid physical BootstrapFrom with ephemeral storage",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						BootstrapFrom: &RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							Method: BackupMethodPhysical,
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Valid VolumeSnapshot BootstrapFrom",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						VolumeClaimTemplate: VolumeClaimTemplate{
+							PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										"storage": resource.MustParse("100Mi"),
+									},
+								},
+								AccessModes: []corev1.PersistentVolumeAccessMode{
+									corev1.ReadWriteOnce,
+								},
+							},
+						},
+						BootstrapFrom: &RestoreSource{
+							VolumeSnapshotRef: &corev1.LocalObjectReference{
+								Name: "volumesnapshot-webhook",
+							},
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid VolumeSnapshot BootstrapFrom with ephemeral storage",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						BootstrapFrom: &RestoreSource{
+							VolumeSnapshotRef: &corev1.LocalObjectReference{
+								Name: "volumesnapshot-webhook",
+							},
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid VolumeSnapshot BootstrapFrom with BackupRef",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						BootstrapFrom: &RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							VolumeSnapshotRef: &corev1.LocalObjectReference{
+								Name: "volumesnapshot-webhook",
+							},
+						},
+					},
+				},
+				true,
+			),
 			Entry(
 				"Valid Galera",
 				&MariaDB{
@@ -248,6 +349,47 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				true,
 			),
+			Entry(
+				"Valid Galera TLS",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled: true,
+						},
+						Galera: &Galera{
+							GaleraSpec: GaleraSpec{
+								TLS: &GaleraTLS{
+									Enabled: true,
+								},
+							},
+							Enabled: true,
+						},
+						Replicas: 3,
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid Galera TLS",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						Galera: &Galera{
+							GaleraSpec: GaleraSpec{
+								TLS: &GaleraTLS{
+									Enabled: true,
+								},
+							},
+							Enabled: true,
+						},
+						Replicas: 3,
+					},
+				},
+				true,
+			),
 			Entry(
 				"Invalid replica wait point",
 				&MariaDB{
@@ -437,6 +579,109 @@ var _ = Describe("MariaDB webhook", func() {
 				},
 				false,
 			),
+			Entry(
+				"Valid TLS issued by operator",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled:                true,
+							RequireSecureTransport: true,
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Valid TLS provided by user",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled: true,
+							ServerCASecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "mariadb-ca",
+								},
+								Key: "ca.crt",
+							},
+							ServerCertSecretRef: &corev1.LocalObjectReference{
+								Name: "mariadb-server-cert",
+							},
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Valid TLS issued by cert-manager",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled: true,
+							IssuerRef: &CertIssuerRef{
+								Name: "ca-issuer",
+								Kind: "ClusterIssuer",
+							},
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid TLS issued by cert-manager",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled: true,
+							IssuerRef: &CertIssuerRef{
+								Name: "ca-issuer",
+							},
+							ServerCertSecretRef: &corev1.LocalObjectReference{
+								Name: "mariadb-server-cert",
+							},
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid TLS lookahead validity",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled:           true,
+							CertValidity:      &metav1.Duration{Duration: 24 * time.Hour},
+							LookaheadValidity: &metav1.Duration{Duration: 48 * time.Hour},
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid TLS",
+				&MariaDB{
+					ObjectMeta: meta,
+					Spec: MariaDBSpec{
+						EphemeralStorage: ptr.To(true),
+						TLS: &MariaDBTLS{
+							Enabled: true,
+							ServerCertSecretRef: &corev1.LocalObjectReference{
+								Name: "mariadb-server-cert",
+							},
+						},
+					},
+				},
+				true,
+			),
 		)
 
 		It("Should default replication", func() {
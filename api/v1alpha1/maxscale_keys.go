@@ -110,3 +110,48 @@ func (m *MaxScale) AuthSyncPasswordSecretKeyRef() corev1.SecretKeySelector {
 		Key: "password",
 	}
 }
+
+// TLSCASecretKey defines the key for the CA Secret issued by the operator.
+func (m *MaxScale) TLSCASecretKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-ca", m.Name),
+		Namespace: m.Namespace,
+	}
+}
+
+// TLSCertSecretKey defines the key for the certificate Secret issued by the operator or by cert-manager.
+func (m *MaxScale) TLSCertSecretKey() types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("%s-cert", m.Name),
+		Namespace: m.Namespace,
+	}
+}
+
+// TLSCASecretKeyRef defines the key selector for the CA certificate used by MaxScale and its clients.
+func (m *MaxScale) TLSCASecretKeyRef() corev1.SecretKeySelector {
+	if m.Spec.TLS != nil && m.Spec.TLS.CASecretKeyRef != nil {
+		return *m.Spec.TLS.CASecretKeyRef
+	}
+	if m.Spec.TLS != nil && m.Spec.TLS.IssuerRef != nil {
+		return corev1.SecretKeySelector{
+			LocalObjectReference: m.TLSCertSecretRef(),
+			Key:                  "ca.crt",
+		}
+	}
+	return corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: m.TLSCASecretKey().Name,
+		},
+		Key: corev1.TLSCertKey,
+	}
+}
+
+// TLSCertSecretRef defines the reference to the TLS Secret containing the MaxScale certificate.
+func (m *MaxScale) TLSCertSecretRef() corev1.LocalObjectReference {
+	if m.Spec.TLS != nil && m.Spec.TLS.CertSecretRef != nil {
+		return *m.Spec.TLS.CertSecretRef
+	}
+	return corev1.LocalObjectReference{
+		Name: m.TLSCertSecretKey().Name,
+	}
+}
@@ -237,6 +237,60 @@ func (m *MaxScaleAdmin) SetDefaults(mxs *MaxScale) {
 	}
 }
 
+// MaxScaleTLS defines the TLS configuration for MaxScale.
+type MaxScaleTLS struct {
+	// Enabled is a flag to enable TLS in the listeners, the admin REST API and the connections to the MariaDB servers.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Enabled bool `json:"enabled,omitempty"`
+	// CASecretKeyRef is a reference to a Secret key containing the CA certificate used to issue the MaxScale certificate.
+	// If not provided, a CA is issued by the operator.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CASecretKeyRef *corev1.SecretKeySelector `json:"caSecretKeyRef,omitempty"`
+	// CertSecretRef is a reference to a TLS Secret containing the MaxScale certificate and private key.
+	// The Secret must contain the 'tls.crt' and 'tls.key' keys. If not provided, a certificate is issued by the operator.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CertSecretRef *corev1.LocalObjectReference `json:"certSecretRef,omitempty"`
+	// IssuerRef is a reference to a cert-manager issuer used to issue the MaxScale certificate.
+	// When provided, a cert-manager Certificate is created instead of issuing the certificates with the operator.
+	// The CA is read from the 'ca.crt' key of the certificate Secret, unless CASecretKeyRef is provided.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	IssuerRef *CertIssuerRef `json:"issuerRef,omitempty"`
+	// ServerCASecretKeyRef is a reference to a Secret key containing the CA certificate used to verify the MariaDB servers.
+	// When provided, TLS is used in the connections to the MariaDB servers.
+	// It is defaulted to the MariaDB CA when 'spec.mariaDbRef' points to a MariaDB with TLS enabled.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServerCASecretKeyRef *corev1.SecretKeySelector `json:"serverCASecretKeyRef,omitempty"`
+	// VerifyServerCertificate indicates whether the certificate presented by the MariaDB servers should be verified, including the hostname.
+	// It defaults to true.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	VerifyServerCertificate *bool `json:"verifyServerCertificate,omitempty"`
+	// CAValidity determines the validity of the CA issued by the operator. It defaults to 4 years.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CAValidity *metav1.Duration `json:"caValidity,omitempty"`
+	// CertValidity determines the validity of the certificate issued by the operator or by cert-manager. It defaults to 1 year.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	CertValidity *metav1.Duration `json:"certValidity,omitempty"`
+	// LookaheadValidity is the time window before expiration in which certificates are renewed. It defaults to 90 days.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	LookaheadValidity *metav1.Duration `json:"lookaheadValidity,omitempty"`
+}
+
+// SetDefaults sets default values.
+func (m *MaxScaleTLS) SetDefaults() {
+	if m.VerifyServerCertificate == nil {
+		m.VerifyServerCertificate = ptr.To(true)
+	}
+}
+
 // MaxScaleConfigSync defines how the config changes are replicated across replicas.
 type MaxScaleConfigSync struct {
 	// Database is the MariaDB logical database where the 'maxscale_config' table will be created in order to persist and synchronize config changes. If not provided, it defaults to 'mysql'.
@@ -481,6 +535,10 @@ type MaxScaleSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	Admin MaxScaleAdmin `json:"admin,omitempty"`
+	// TLS defines the TLS configuration for the listeners, the admin REST API and the connections to the MariaDB servers.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	TLS *MaxScaleTLS `json:"tls,omitempty"`
 	// Config defines the MaxScale configuration.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
@@ -672,6 +730,9 @@ func (m *MaxScale) SetDefaults(env *environment.Environment) {
 	}
 	m.Spec.Monitor.SetDefaults(m)
 	m.Spec.Admin.SetDefaults(m)
+	if m.Spec.TLS != nil {
+		m.Spec.TLS.SetDefaults()
+	}
 	m.Spec.Config.SetDefaults(m)
 	m.Spec.Auth.SetDefaults(m)
 }
@@ -686,6 +747,27 @@ func (m *MaxScale) IsHAEnabled() bool {
 	return m.Spec.Replicas > 1
 }
 
+// IsTLSEnabled indicates whether the MaxScale instance has TLS enabled.
+func (m *MaxScale) IsTLSEnabled() bool {
+	return m.Spec.TLS != nil && m.Spec.TLS.Enabled
+}
+
+// IsTLSIssuedByOperator indicates whether the TLS certificates are issued by the operator.
+func (m *MaxScale) IsTLSIssuedByOperator() bool {
+	return m.IsTLSEnabled() && m.Spec.TLS.IssuerRef == nil &&
+		m.Spec.TLS.CASecretKeyRef == nil && m.Spec.TLS.CertSecretRef == nil
+}
+
+// IsTLSIssuedByCertManager indicates whether the TLS certificates are issued by cert-manager.
+func (m *MaxScale) IsTLSIssuedByCertManager() bool {
+	return m.IsTLSEnabled() && m.Spec.TLS.IssuerRef != nil
+}
+
+// IsServerTLSEnabled indicates whether the connections to the MariaDB servers use TLS.
+func (m *MaxScale) IsServerTLSEnabled() bool {
+	return m.IsTLSEnabled() && m.Spec.TLS.ServerCASecretKeyRef != nil
+}
+
 // APIUrl returns the URL of the admin API pointing to the Kubernetes Service.
 func (m *MaxScale) APIUrl() string {
 	fqdn := statefulset.ServiceFQDNWithService(m.ObjectMeta, m.Name)
@@ -762,7 +844,11 @@ func (m *MaxScale) DefaultPort() (*int32, error) {
 }
 
 func (m *MaxScale) apiUrlWithAddress(addr string) string {
-	return fmt.Sprintf("http://%s:%d", addr, m.Spec.Admin.Port)
+	scheme := "http"
+	if m.IsTLSEnabled() {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, addr, m.Spec.Admin.Port)
 }
 
 func (m *MaxScale) defaultConnections() int32 {
@@ -32,6 +32,7 @@ func (r *MaxScale) ValidateCreate() (admission.Warnings, error) {
 		r.validateMonitor,
 		r.validateServices,
 		r.validatePodDisruptionBudget,
+		r.validateTLS,
 	}
 	for _, fn := range validateFns {
 		if err := fn(); err != nil {
@@ -55,6 +56,7 @@ func (r *MaxScale) ValidateUpdate(old runtime.Object) (admission.Warnings, error
 		r.validateMonitor,
 		r.validateServices,
 		r.validatePodDisruptionBudget,
+		r.validateTLS,
 	}
 	for _, fn := range validateFns {
 		if err := fn(); err != nil {
@@ -184,3 +186,33 @@ func (r *MaxScale) validatePodDisruptionBudget() error {
 	}
 	return nil
 }
+
+func (r *MaxScale) validateTLS() error {
+	if !r.IsTLSEnabled() {
+		return nil
+	}
+	if r.Spec.TLS.IssuerRef != nil {
+		if r.Spec.TLS.CertSecretRef != nil {
+			return field.Invalid(
+				field.NewPath("spec").Child("tls").Child("issuerRef"),
+				r.Spec.TLS.IssuerRef,
+				"'spec.tls.issuerRef' and 'spec.tls.certSecretRef' cannot be specified simultaneously",
+			)
+		}
+	} else if (r.Spec.TLS.CASecretKeyRef == nil) != (r.Spec.TLS.CertSecretRef == nil) {
+		return field.Invalid(
+			field.NewPath("spec").Child("tls"),
+			r.Spec.TLS,
+			"'spec.tls.caSecretKeyRef' and 'spec.tls.certSecretRef' must be provided together",
+		)
+	}
+	if r.Spec.TLS.CertValidity != nil && r.Spec.TLS.LookaheadValidity != nil &&
+		r.Spec.TLS.LookaheadValidity.Duration >= r.Spec.TLS.CertValidity.Duration {
+		return field.Invalid(
+			field.NewPath("spec").Child("tls").Child("lookaheadValidity"),
+			r.Spec.TLS.LookaheadValidity,
+			"'spec.tls.lookaheadValidity' must be lower than 'spec.tls.certValidity'",
+		)
+	}
+	return nil
+}
@@ -372,6 +372,75 @@ var _ = Describe("MaxScale webhook", func() {
 				},
 				false,
 			),
+			Entry(
+				"Valid TLS",
+				&MaxScale{
+					ObjectMeta: meta,
+					Spec: MaxScaleSpec{
+						MariaDBRef: &MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb",
+							},
+						},
+						TLS: &MaxScaleTLS{
+							Enabled: true,
+							CASecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "maxscale-ca",
+								},
+								Key: "tls.crt",
+							},
+							CertSecretRef: &corev1.LocalObjectReference{
+								Name: "maxscale-cert",
+							},
+						},
+					},
+				},
+				false,
+			),
+			Entry(
+				"Invalid TLS",
+				&MaxScale{
+					ObjectMeta: meta,
+					Spec: MaxScaleSpec{
+						MariaDBRef: &MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb",
+							},
+						},
+						TLS: &MaxScaleTLS{
+							Enabled: true,
+							CertSecretRef: &corev1.LocalObjectReference{
+								Name: "maxscale-cert",
+							},
+						},
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid TLS issued by cert-manager",
+				&MaxScale{
+					ObjectMeta: meta,
+					Spec: MaxScaleSpec{
+						MariaDBRef: &MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb",
+							},
+						},
+						TLS: &MaxScaleTLS{
+							Enabled: true,
+							IssuerRef: &CertIssuerRef{
+								Name: "issuer",
+							},
+							CertSecretRef: &corev1.LocalObjectReference{
+								Name: "maxscale-cert",
+							},
+						},
+					},
+				},
+				true,
+			),
 		)
 	})
 
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,5 +43,8 @@ func (r *Restore) validate() (admission.Warnings, error) {
 	if err := r.Spec.RestoreSource.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid restore: %v", err)
 	}
+	if r.Spec.RestoreSource.IsVolumeSnapshot() {
+		return nil, errors.New("invalid restore: volumeSnapshotRef is only supported when bootstrapping a new MariaDB")
+	}
 	return nil, nil
 }
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -78,6 +79,56 @@ var _ = Describe("Restore webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				false,
+			),
+			Entry(
+				"S3 streaming source with binlogs",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							S3: &S3{
+								Bucket:    "test",
+								Endpoint:  "test",
+								IAM:       true,
+								Streaming: true,
+							},
+							ReplayBinlogs: ptr.To(true),
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"S3 streaming source",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							S3: &S3{
+								Bucket:    "test",
+								Endpoint:  "test",
+								IAM:       true,
+								Streaming: true,
 							},
 						},
 						MariaDBRef: MariaDBRef{
@@ -114,6 +165,150 @@ var _ = Describe("Restore webhook", func() {
 				},
 				false,
 			),
+			Entry(
+				"Database with Physical method",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							Method:   BackupMethodPhysical,
+							Database: "db1",
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"VolumeSnapshotRef",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							VolumeSnapshotRef: &corev1.LocalObjectReference{
+								Name: "volumesnapshot-webhook",
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"TargetDatabase without Database",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							TargetDatabase: "db1",
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Invalid Database name",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							Database: "db1`; DROP DATABASE db2; --",
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"Mydumper engine with Physical method",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							BackupRef: &corev1.LocalObjectReference{
+								Name: "backup-webhook",
+							},
+							Method: BackupMethodPhysical,
+							Engine: BackupEngineMydumper,
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
+			Entry(
+				"S3 and AzureBlob source",
+				&Restore{
+					ObjectMeta: objMeta,
+					Spec: RestoreSpec{
+						RestoreSource: RestoreSource{
+							S3: &S3{
+								Bucket:   "test",
+								Endpoint: "test",
+								IAM:      true,
+							},
+							AzureBlob: &AzureBlob{
+								Container:      "test",
+								StorageAccount: "test",
+								AccountKeySecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "azure",
+									},
+									Key: "account-key",
+								},
+							},
+						},
+						MariaDBRef: MariaDBRef{
+							ObjectReference: corev1.ObjectReference{
+								Name: "mariadb-webhook",
+							},
+							WaitForIt: true,
+						},
+						BackoffLimit: 10,
+					},
+				},
+				true,
+			),
 			Entry(
 				"S3 and Volume source",
 				&Restore{
@@ -123,6 +318,7 @@ var _ = Describe("Restore webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
 							},
 							Volume: &corev1.VolumeSource{
 								EmptyDir: &corev1.EmptyDirVolumeSource{},
@@ -151,6 +347,7 @@ var _ = Describe("Restore webhook", func() {
 							S3: &S3{
 								Bucket:   "test",
 								Endpoint: "test",
+								IAM:      true,
 							},
 							Volume: &corev1.VolumeSource{
 								EmptyDir: &corev1.EmptyDirVolumeSource{},
@@ -266,6 +463,7 @@ var _ = Describe("Restore webhook", func() {
 					rmdb.Spec.RestoreSource.S3 = &S3{
 						Bucket:   "test",
 						Endpoint: "test",
+						IAM:      true,
 					}
 				},
 				false,
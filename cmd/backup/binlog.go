@@ -0,0 +1,141 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mariadb-operator/mariadb-operator/pkg/backup"
+	"github.com/spf13/cobra"
+)
+
+var binlogMaxRetention time.Duration
+
+func init() {
+	binlogPushCommand.Flags().DurationVar(&binlogMaxRetention, "max-retention", 30*24*time.Hour,
+		"Defines the retention policy for archived binary logs. Older binary logs will be deleted.")
+
+	binlogCommand.AddCommand(binlogListCommand)
+	binlogCommand.AddCommand(binlogPushCommand)
+}
+
+var binlogCommand = &cobra.Command{
+	Use:   "binlog",
+	Short: "Binlog.",
+	Long:  `Manages the archived binary logs to implement point in time recovery.`,
+	Args:  cobra.NoArgs,
+}
+
+var binlogListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "List.",
+	Long:  `Writes the keys of the archived binary logs, in '<server>.<binlog name>' format, into the target file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupLogger(cmd); err != nil {
+			fmt.Printf("error setting up logger: %v\n", err)
+			os.Exit(1)
+		}
+		logger.Info("listing archived binlogs")
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		binlogStorage, err := getBinlogStorage()
+		if err != nil {
+			logger.Error(err, "error getting binlog storage")
+			os.Exit(1)
+		}
+		if err := os.MkdirAll(getBinlogsPath(), 0777); err != nil {
+			logger.Error(err, "error creating binlogs directory")
+			os.Exit(1)
+		}
+
+		binlogFileNames, err := binlogStorage.List(ctx)
+		if err != nil {
+			logger.Error(err, "error listing binlog files")
+			os.Exit(1)
+		}
+		var binlogKeys []string
+		for _, file := range binlogFileNames {
+			binlogKey, err := backup.GetBinlogKey(file)
+			if err != nil {
+				logger.Error(err, "error getting binlog key. Skipping", "file", file)
+				continue
+			}
+			binlogKeys = append(binlogKeys, binlogKey)
+		}
+		logger.Info("obtained archived binlogs", "binlogs", len(binlogKeys))
+
+		logger.Info("writing target file", "path", targetFilePath)
+		if err := writeTargetFile(strings.Join(binlogKeys, "\n")); err != nil {
+			logger.Error(err, "error writing target file", "path", targetFilePath)
+			os.Exit(1)
+		}
+	},
+}
+
+var binlogPushCommand = &cobra.Command{
+	Use:   "push",
+	Short: "Push.",
+	Long:  `Pushes the binary logs that have not been archived yet and cleans up the old ones.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupLogger(cmd); err != nil {
+			fmt.Printf("error setting up logger: %v\n", err)
+			os.Exit(1)
+		}
+		logger.Info("archiving binlogs")
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		binlogStorage, err := getBinlogStorage()
+		if err != nil {
+			logger.Error(err, "error getting binlog storage")
+			os.Exit(1)
+		}
+
+		archivedBinlogs, err := binlogStorage.List(ctx)
+		if err != nil {
+			logger.Error(err, "error listing binlog files")
+			os.Exit(1)
+		}
+		isArchived := make(map[string]bool, len(archivedBinlogs))
+		for _, file := range archivedBinlogs {
+			isArchived[file] = true
+		}
+
+		entries, err := os.ReadDir(getBinlogsPath())
+		if err != nil {
+			logger.Error(err, "error reading binlogs directory")
+			os.Exit(1)
+		}
+		for _, e := range entries {
+			file := e.Name()
+			if !backup.IsValidBinlogFile(file) || isArchived[file] {
+				continue
+			}
+			logger.Info("pushing binlog", "file", file)
+			if err := binlogStorage.Push(ctx, file); err != nil {
+				logger.Error(err, "error pushing binlog", "file", file)
+				os.Exit(1)
+			}
+			archivedBinlogs = append(archivedBinlogs, file)
+		}
+
+		logger.Info("cleaning up old binlogs")
+		oldBinlogs := backup.GetOldBinlogFiles(archivedBinlogs, binlogMaxRetention, logger.WithName("binlog-cleanup"))
+		if len(oldBinlogs) == 0 {
+			logger.Info("no old binlogs were found")
+			os.Exit(0)
+		}
+		logger.Info("old binlogs to delete", "binlogs", len(oldBinlogs))
+
+		for _, binlog := range oldBinlogs {
+			logger.V(1).Info("deleting old binlog", "binlog", binlog)
+			if err := binlogStorage.Delete(ctx, binlog); err != nil {
+				logger.Error(err, "error removing old binlog", "binlog", binlog)
+			}
+		}
+	},
+}
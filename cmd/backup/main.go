@@ -2,9 +2,12 @@ package backup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
@@ -24,8 +27,47 @@ var (
 	s3Region       string
 	s3TLS          bool
 	s3CACertPath   string
+	s3IAM          bool
+	s3SSE          string
+	s3SSEKMSKeyID  string
+	s3SSECKeyPath  string
+	s3StorageClass string
+	s3Tags         map[string]string
 	s3Prefix       string
+	s3Streaming    bool
 	maxRetention   time.Duration
+
+	keepHourly  int
+	keepDaily   int
+	keepWeekly  int
+	keepMonthly int
+	keepYearly  int
+
+	azureBlob               bool
+	azureBlobContainer      string
+	azureBlobStorageAccount string
+	azureBlobServiceURL     string
+	azureBlobPrefix         string
+
+	gcs                bool
+	gcsBucket          string
+	gcsPrefix          string
+	gcsCredentialsPath string
+
+	encryptionKeyPath string
+
+	catalogPath  string
+	catalogLimit int
+
+	dumpOpts string
+)
+
+const (
+	azureBlobAccountKeyEnv = "AZURE_STORAGE_ACCOUNT_KEY"
+	azureBlobSASTokenEnv   = "AZURE_STORAGE_SAS_TOKEN"
+
+	streamStartTimeout    = 10 * time.Minute
+	streamCompleteTimeout = 30 * time.Second
 )
 
 func init() {
@@ -48,12 +90,55 @@ func init() {
 	RootCmd.PersistentFlags().BoolVar(&s3TLS, "s3-tls", false, "Enable S3 TLS connections.")
 	RootCmd.PersistentFlags().StringVar(&s3CACertPath, "s3-ca-cert-path", "s3/pki/tls.crt",
 		"Path to the CA to be trusted when connecting to S3.")
+	RootCmd.PersistentFlags().BoolVar(&s3IAM, "s3-iam", false, "Obtain the S3 credentials from the AWS IAM providers, "+
+		"supporting web identity (IRSA), ECS task roles and EC2 instance profiles, instead of from the environment variables.")
+	RootCmd.PersistentFlags().StringVar(&s3SSE, "s3-sse", "", "S3 server-side encryption type. "+
+		"Supported values: "+backup.S3SSES3+", "+backup.S3SSEKMS+" and "+backup.S3SSEC+".")
+	RootCmd.PersistentFlags().StringVar(&s3SSEKMSKeyID, "s3-sse-kms-key-id", "", "AWS KMS key ID to be used by "+backup.S3SSEKMS+".")
+	RootCmd.PersistentFlags().StringVar(&s3SSECKeyPath, "s3-sse-customer-key-path", "",
+		"Path to the AES-256 key to be used by "+backup.S3SSEC+".")
+	RootCmd.PersistentFlags().StringVar(&s3StorageClass, "s3-storage-class", "", "S3 storage class of the backup files.")
+	RootCmd.PersistentFlags().StringToStringVar(&s3Tags, "s3-tags", nil, "S3 object tags of the backup files.")
 	RootCmd.PersistentFlags().StringVar(&s3Prefix, "s3-prefix", "", "S3 bucket prefix name to use.")
+	RootCmd.PersistentFlags().BoolVar(&s3Streaming, "s3-streaming", false,
+		"Stream the backups from and to S3 through a named pipe in the path, instead of staging them locally.")
+
+	RootCmd.PersistentFlags().BoolVar(&azureBlob, "azure-blob", false, "Enable Azure Blob backup storage. "+
+		"Credentials are read from the "+azureBlobAccountKeyEnv+" or "+azureBlobSASTokenEnv+" environment variables.")
+	RootCmd.PersistentFlags().StringVar(&azureBlobContainer, "azure-blob-container", "backups",
+		"Name of the Azure Blob container to store backups.")
+	RootCmd.PersistentFlags().StringVar(&azureBlobStorageAccount, "azure-blob-storage-account", "",
+		"Name of the Azure storage account.")
+	RootCmd.PersistentFlags().StringVar(&azureBlobServiceURL, "azure-blob-service-url", "",
+		"Azure Blob service URL. It defaults to https://<storage-account>.blob.core.windows.net/.")
+	RootCmd.PersistentFlags().StringVar(&azureBlobPrefix, "azure-blob-prefix", "", "Azure Blob container prefix name to use.")
+
+	RootCmd.PersistentFlags().BoolVar(&gcs, "gcs", false, "Enable Google Cloud Storage backup storage. "+
+		"Application Default Credentials are used when no credentials file is provided.")
+	RootCmd.PersistentFlags().StringVar(&gcsBucket, "gcs-bucket", "backups", "Name of the GCS bucket to store backups.")
+	RootCmd.PersistentFlags().StringVar(&gcsPrefix, "gcs-prefix", "", "GCS bucket prefix name to use.")
+	RootCmd.PersistentFlags().StringVar(&gcsCredentialsPath, "gcs-credentials-path", "",
+		"Path to the service account JSON key used to authenticate against GCS.")
+
+	RootCmd.PersistentFlags().StringVar(&encryptionKeyPath, "encryption-key-path", "",
+		"Path to the AES-256 key used to encrypt and decrypt the backup files. Encryption is disabled when not provided.")
 
 	RootCmd.Flags().DurationVar(&maxRetention, "max-retention", 30*24*time.Hour,
 		"Defines the retention policy for backups. Older backups will be deleted.")
+	RootCmd.Flags().StringVar(&catalogPath, "catalog-path", "",
+		"Path where the catalog of the latest backups is written after taking a backup. It is disabled when not provided.")
+	RootCmd.Flags().IntVar(&catalogLimit, "catalog-limit", 10, "Maximum number of backups to be listed in the catalog. "+
+		"The oldest backups are further dropped to fit the catalog in the termination message.")
+	RootCmd.Flags().StringVar(&dumpOpts, "dump-opts", "", "Options used to take the backup, to be recorded in the backup manifest.")
+	RootCmd.Flags().IntVar(&keepHourly, "keep-hourly", 0,
+		"Number of hourly backups to keep. Setting any of the keep flags enables the grandfather-father-son retention policy.")
+	RootCmd.Flags().IntVar(&keepDaily, "keep-daily", 0, "Number of daily backups to keep.")
+	RootCmd.Flags().IntVar(&keepWeekly, "keep-weekly", 0, "Number of weekly backups to keep.")
+	RootCmd.Flags().IntVar(&keepMonthly, "keep-monthly", 0, "Number of monthly backups to keep.")
+	RootCmd.Flags().IntVar(&keepYearly, "keep-yearly", 0, "Number of yearly backups to keep.")
 
 	RootCmd.AddCommand(restoreCommand)
+	RootCmd.AddCommand(binlogCommand)
 }
 
 var RootCmd = &cobra.Command{
@@ -77,18 +162,19 @@ var RootCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		logger.Info("reading target file", "path", targetFilePath)
-		backupTargetFile, err := readTargetFile()
-		if err != nil {
-			logger.Error(err, "error reading target file", "path", targetFilePath)
-			os.Exit(1)
-		}
-		logger.Info("obtained target backup", "file", backupTargetFile)
-
-		logger.Info("pushing target backup", "file", backupTargetFile)
-		if err := backupStorage.Push(ctx, backupTargetFile); err != nil {
-			logger.Error(err, "error pushing target backup", "file", backupTargetFile)
-			os.Exit(1)
+		var backupTargetFiles []string
+		if s3Streaming {
+			backupTargetFiles, err = streamTargetBackup(ctx, backupStorage)
+			if err != nil {
+				logger.Error(err, "error streaming target backup")
+				os.Exit(1)
+			}
+		} else {
+			backupTargetFiles, err = pushTargetBackups(ctx, backupStorage)
+			if err != nil {
+				logger.Error(err, "error pushing target backups")
+				os.Exit(1)
+			}
 		}
 
 		backupNames, err := backupStorage.List(ctx)
@@ -98,22 +184,211 @@ var RootCmd = &cobra.Command{
 		}
 
 		logger.Info("cleaning up old backups")
-		oldBackups := backup.GetOldBackupFiles(backupNames, maxRetention, logger.WithName("backup-cleanup"))
+		oldBackups := getOldBackupFiles(backupNames)
+		deletedBackups := make(map[string]bool)
 		if len(oldBackups) == 0 {
 			logger.Info("no old backups were found")
-			os.Exit(0)
+		} else {
+			logger.Info("old backups to delete", "backups", len(oldBackups))
 		}
-		logger.Info("old backups to delete", "backups", len(oldBackups))
 
 		for _, backup := range oldBackups {
 			logger.V(1).Info("deleting old backup", "backup", backup)
 			if err := backupStorage.Delete(ctx, backup); err != nil {
 				logger.Error(err, "error removing old backup", "backup", backup)
+				continue
+			}
+			deletedBackups[backup] = true
+		}
+
+		if catalogPath != "" {
+			logger.Info("writing backup catalog", "path", catalogPath)
+			if err := writeCatalog(backupTargetFiles, backupNames, deletedBackups); err != nil {
+				logger.Error(err, "error writing backup catalog", "path", catalogPath)
 			}
 		}
 	},
 }
 
+func pushTargetBackups(ctx context.Context, backupStorage backup.BackupStorage) ([]string, error) {
+	logger.Info("reading target file", "path", targetFilePath)
+	backupTargetFiles, err := readTargetFiles()
+	if err != nil {
+		return nil, fmt.Errorf("error reading target file: %v", err)
+	}
+	logger.Info("obtained target backups", "files", backupTargetFiles)
+
+	if encryptionKeyPath != "" {
+		if err := encryptBackupFiles(backupTargetFiles); err != nil {
+			return nil, fmt.Errorf("error encrypting target backups: %v", err)
+		}
+	}
+
+	if err := writeManifests(backupTargetFiles); err != nil {
+		return nil, fmt.Errorf("error writing target backup manifests: %v", err)
+	}
+
+	for _, backupTargetFile := range backupTargetFiles {
+		logger.Info("pushing target backup", "file", backupTargetFile)
+		if err := backupStorage.Push(ctx, backupTargetFile); err != nil {
+			return nil, fmt.Errorf("error pushing target backup %s: %v", backupTargetFile, err)
+		}
+	}
+	return backupTargetFiles, nil
+}
+
+// streamTargetBackup uploads the target backup while it is being taken, reading it from the stream written by the backup container.
+func streamTargetBackup(ctx context.Context, backupStorage *backup.ManifestBackupStorage) ([]string, error) {
+	streamPath := filepath.Join(path, backup.StreamFile)
+	logger.Info("waiting for backup stream", "path", streamPath)
+	if err := backup.WaitForFile(ctx, streamPath, streamStartTimeout); err != nil {
+		return nil, fmt.Errorf("error waiting for backup stream: %v", err)
+	}
+
+	backupTargetFiles, err := readTargetFiles()
+	if err != nil {
+		return nil, fmt.Errorf("error reading target file: %v", err)
+	}
+	if len(backupTargetFiles) != 1 {
+		return nil, fmt.Errorf("a single target backup can be streamed, got %d", len(backupTargetFiles))
+	}
+	backupTargetFile := backupTargetFiles[0]
+	serverVersion, err := backup.ReadServerVersion(filepath.Join(path, backup.ServerVersionFile))
+	if err != nil {
+		return nil, fmt.Errorf("error reading target backup server version: %v", err)
+	}
+
+	stream, err := os.Open(streamPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening backup stream: %v", err)
+	}
+	defer stream.Close()
+
+	manifest := backup.Manifest{
+		FileName:      backupTargetFile,
+		DumpOptions:   dumpOpts,
+		ServerVersion: serverVersion,
+		Time:          time.Now().UTC(),
+	}
+	logger.Info("streaming target backup", "file", backupTargetFile)
+	reader := backup.NewCompleteStreamReader(ctx, stream, filepath.Join(path, backup.StreamCompleteFile), streamCompleteTimeout)
+	if err := backupStorage.PushStream(ctx, &manifest, reader); err != nil {
+		return nil, fmt.Errorf("error streaming target backup %s: %v", backupTargetFile, err)
+	}
+	logger.Info("streamed target backup", "file", backupTargetFile, "size", manifest.Size, "sha256", manifest.SHA256)
+	return backupTargetFiles, nil
+}
+
+// writeCatalog writes the latest backups available in the storage, so they can be reported in the Backup status.
+func writeCatalog(backupTargetFiles []string, backupNames []string, deletedBackups map[string]bool) error {
+	gtid, err := backup.ReadGtid(filepath.Join(path, backup.GtidFile))
+	if err != nil {
+		return fmt.Errorf("error reading target backup GTID: %v", err)
+	}
+	var targets []backup.CatalogEntry
+	for _, backupTargetFile := range backupTargetFiles {
+		manifest, err := backup.ReadManifest(filepath.Join(path, backup.ManifestFile(backupTargetFile)))
+		if err != nil {
+			return fmt.Errorf("error reading target backup manifest: %v", err)
+		}
+		target, err := backup.NewCatalogEntryFromManifest(manifest)
+		if err != nil {
+			return fmt.Errorf("error describing target backup: %v", err)
+		}
+		if target.GTID == "" {
+			target.GTID = gtid
+		}
+		targets = append(targets, target)
+	}
+
+	var availableBackups []string
+	for _, backupName := range backupNames {
+		if !deletedBackups[backupName] {
+			availableBackups = append(availableBackups, backupName)
+		}
+	}
+	catalog := backup.NewCatalog(targets, availableBackups, catalogLimit, logger.WithName("backup-catalog"))
+	summary := backup.NewCatalogSummary(targets, len(availableBackups), time.Now().UTC())
+	catalog.Summary = &summary
+	logger.Info(
+		"backup completed",
+		"duration-seconds", summary.DurationSeconds,
+		"size", summary.Size,
+		"file-count", summary.FileCount,
+	)
+	return backup.WriteCatalog(catalog, catalogPath)
+}
+
+// writeManifests writes the manifest of the target backups, which is pushed along with them to allow checking their integrity.
+func writeManifests(backupTargetFiles []string) error {
+	gtid, err := backup.ReadGtid(filepath.Join(path, backup.GtidFile))
+	if err != nil {
+		return fmt.Errorf("error reading target backup GTID: %v", err)
+	}
+	serverVersion, err := backup.ReadServerVersion(filepath.Join(path, backup.ServerVersionFile))
+	if err != nil {
+		return fmt.Errorf("error reading target backup server version: %v", err)
+	}
+	for _, backupTargetFile := range backupTargetFiles {
+		manifest, err := backup.NewManifest(filepath.Join(path, backupTargetFile))
+		if err != nil {
+			return fmt.Errorf("error describing target backup %s: %v", backupTargetFile, err)
+		}
+		manifest.DumpOptions = dumpOpts
+		manifest.ServerVersion = serverVersion
+		manifest.GTID = gtid
+
+		logger.Info("writing target backup manifest", "file", backupTargetFile, "sha256", manifest.SHA256)
+		if err := backup.WriteManifest(manifest, filepath.Join(path, backup.ManifestFile(backupTargetFile))); err != nil {
+			return fmt.Errorf("error writing manifest of target backup %s: %v", backupTargetFile, err)
+		}
+	}
+	return nil
+}
+
+func getOldBackupFiles(backupNames []string) []string {
+	cleanupLogger := logger.WithName("backup-cleanup")
+	retentionPolicy := backup.RetentionPolicy{
+		Hourly:  keepHourly,
+		Daily:   keepDaily,
+		Weekly:  keepWeekly,
+		Monthly: keepMonthly,
+		Yearly:  keepYearly,
+	}
+	if retentionPolicy.IsEnabled() {
+		cleanupLogger.V(1).Info("using retention policy", "policy", retentionPolicy)
+		return backup.GetOldBackupFilesWithPolicy(backupNames, retentionPolicy, cleanupLogger)
+	}
+	return backup.GetOldBackupFiles(backupNames, maxRetention, cleanupLogger)
+}
+
+// encryptBackupFiles encrypts the backup files, replacing the plaintext files and the target file contents.
+// Backup files that are already encrypted are left as is.
+func encryptBackupFiles(backupFiles []string) error {
+	key, err := backup.ReadEncryptionKey(encryptionKeyPath)
+	if err != nil {
+		return err
+	}
+	for i, backupFile := range backupFiles {
+		if backup.IsEncryptedBackupFile(backupFile) {
+			continue
+		}
+		logger.Info("encrypting target backup", "file", backupFile)
+		encryptedFile := backup.EncryptedBackupFile(backupFile)
+		if err := backup.EncryptFile(filepath.Join(path, backupFile), filepath.Join(path, encryptedFile), key); err != nil {
+			return fmt.Errorf("error encrypting file %s: %v", backupFile, err)
+		}
+		if err := os.Remove(filepath.Join(path, backupFile)); err != nil {
+			return fmt.Errorf("error removing unencrypted file %s: %v", backupFile, err)
+		}
+		backupFiles[i] = encryptedFile
+	}
+	if err := writeTargetFile(strings.Join(backupFiles, "\n")); err != nil {
+		return fmt.Errorf("error writing target file: %v", err)
+	}
+	return nil
+}
+
 func setupLogger(cmd *cobra.Command) error {
 	logLevel, err := cmd.Flags().GetString("log-level")
 	if err != nil {
@@ -141,25 +416,96 @@ func newContext() (context.Context, context.CancelFunc) {
 	)
 }
 
-func getBackupStorage() (backup.BackupStorage, error) {
+// getBackupStorage returns a storage that manages the backup files along with their manifests.
+func getBackupStorage() (*backup.ManifestBackupStorage, error) {
+	storage, err := getBackupFileStorage()
+	if err != nil {
+		return nil, err
+	}
+	return backup.NewManifestBackupStorage(storage, path, logger.WithName("manifest-storage")), nil
+}
+
+func getBackupFileStorage() (backup.BackupStorage, error) {
 	if s3 {
 		logger.Info("configuring S3 backup storage")
-		return getS3BackupStorage()
+		return getS3BackupStorage(path, s3Prefix, backup.IsValidBackupOrManifestFile)
+	}
+	if azureBlob {
+		logger.Info("configuring Azure Blob backup storage")
+		return getAzureBlobBackupStorage(path, azureBlobPrefix, backup.IsValidBackupOrManifestFile)
+	}
+	if gcs {
+		logger.Info("configuring GCS backup storage")
+		return getGCSBackupStorage(path, gcsPrefix, backup.IsValidBackupOrManifestFile)
 	}
 	logger.Info("configuring filesystem backup storage")
-	return backup.NewFileSystemBackupStorage(path, logger.WithName("file-system-storage")), nil
+	return backup.NewFileSystemBackupStorage(
+		path,
+		logger.WithName("file-system-storage"),
+		backup.WithFileSystemFileFilter(backup.IsValidBackupOrManifestFile),
+	), nil
 }
 
-func getS3BackupStorage() (backup.BackupStorage, error) {
+func getBinlogStorage() (backup.BackupStorage, error) {
+	binlogsPath := getBinlogsPath()
+	if s3 {
+		logger.Info("configuring S3 binlog storage")
+		return getS3BackupStorage(binlogsPath, getBinlogsPrefix(s3Prefix), backup.IsValidBinlogFile)
+	}
+	if azureBlob {
+		logger.Info("configuring Azure Blob binlog storage")
+		return getAzureBlobBackupStorage(binlogsPath, getBinlogsPrefix(azureBlobPrefix), backup.IsValidBinlogFile)
+	}
+	if gcs {
+		logger.Info("configuring GCS binlog storage")
+		return getGCSBackupStorage(binlogsPath, getBinlogsPrefix(gcsPrefix), backup.IsValidBinlogFile)
+	}
+	logger.Info("configuring filesystem binlog storage")
+	return backup.NewFileSystemBackupStorage(
+		binlogsPath,
+		logger.WithName("file-system-binlog-storage"),
+		backup.WithFileSystemFileFilter(backup.IsValidBinlogFile),
+	), nil
+}
+
+func getBinlogsPrefix(prefix string) string {
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix + backup.BinlogsDir + "/"
+	}
+	return prefix + "/" + backup.BinlogsDir + "/"
+}
+
+func getS3BackupStorage(basePath, prefix string, fileFilter backup.FileFilter) (backup.BackupStorage, error) {
 	opts := []backup.S3BackupStorageOpt{
 		backup.WithRegion(s3Region),
-		backup.WithPrefix(s3Prefix),
+		backup.WithPrefix(prefix),
+		backup.WithFileFilter(fileFilter),
 	}
 	if s3TLS {
 		opts = append(opts, backup.WithTLS(s3CACertPath))
 	}
+	if s3IAM {
+		opts = append(opts, backup.WithIAM())
+	}
+	if s3SSE != "" {
+		var customerKey []byte
+		if s3SSECKeyPath != "" {
+			key, err := backup.ReadEncryptionKey(s3SSECKeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("error reading S3 SSE-C key: %v", err)
+			}
+			customerKey = key
+		}
+		opts = append(opts, backup.WithSSE(s3SSE, s3SSEKMSKeyID, customerKey))
+	}
+	if s3StorageClass != "" {
+		opts = append(opts, backup.WithStorageClass(s3StorageClass))
+	}
+	if len(s3Tags) > 0 {
+		opts = append(opts, backup.WithTags(s3Tags))
+	}
 	return backup.NewS3BackupStorage(
-		path,
+		basePath,
 		s3Bucket,
 		s3Endpoint,
 		logger.WithName("s3-storage"),
@@ -167,10 +513,59 @@ func getS3BackupStorage() (backup.BackupStorage, error) {
 	)
 }
 
-func readTargetFile() (string, error) {
+func getAzureBlobBackupStorage(basePath, prefix string, fileFilter backup.FileFilter) (backup.BackupStorage, error) {
+	opts := []backup.AzureBlobBackupStorageOpt{
+		backup.WithAzureBlobPrefix(prefix),
+		backup.WithAzureBlobFileFilter(fileFilter),
+		backup.WithAzureBlobAccountKey(os.Getenv(azureBlobAccountKeyEnv)),
+		backup.WithAzureBlobSASToken(os.Getenv(azureBlobSASTokenEnv)),
+	}
+	if azureBlobServiceURL != "" {
+		opts = append(opts, backup.WithAzureBlobServiceURL(azureBlobServiceURL))
+	}
+	return backup.NewAzureBlobBackupStorage(
+		basePath,
+		azureBlobContainer,
+		azureBlobStorageAccount,
+		logger.WithName("azure-blob-storage"),
+		opts...,
+	)
+}
+
+func getGCSBackupStorage(basePath, prefix string, fileFilter backup.FileFilter) (backup.BackupStorage, error) {
+	opts := []backup.GCSBackupStorageOpt{
+		backup.WithGCSPrefix(prefix),
+		backup.WithGCSFileFilter(fileFilter),
+	}
+	if gcsCredentialsPath != "" {
+		opts = append(opts, backup.WithGCSCredentialsPath(gcsCredentialsPath))
+	}
+	return backup.NewGCSBackupStorage(
+		basePath,
+		gcsBucket,
+		logger.WithName("gcs-storage"),
+		opts...,
+	)
+}
+
+func getBinlogsPath() string {
+	return filepath.Join(path, backup.BinlogsDir)
+}
+
+// readTargetFiles reads the backup target files, which are written one per line.
+func readTargetFiles() ([]string, error) {
 	bytes, err := os.ReadFile(targetFilePath)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	var files []string
+	for _, file := range strings.Split(string(bytes), "\n") {
+		if file = strings.TrimSpace(file); file != "" {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no target files found")
 	}
-	return string(bytes), nil
+	return files, nil
 }
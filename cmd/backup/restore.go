@@ -1,19 +1,39 @@
 package backup
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/mariadb-operator/mariadb-operator/pkg/backup"
 	"github.com/spf13/cobra"
 )
 
-var targetTimeRaw string
+var (
+	targetTimeRaw string
+	physical      bool
+	binlogs       bool
+	decryptPath   string
+	database      string
+)
 
 func init() {
 	restoreCommand.Flags().StringVar(&targetTimeRaw, "target-time", "",
 		"RFC3339 (1970-01-01T00:00:00Z) date and time that defines the backup target time.")
+	restoreCommand.Flags().BoolVar(&physical, "physical", false,
+		"Whether to restore a physical backup taken with mariabackup.")
+	restoreCommand.Flags().BoolVar(&binlogs, "binlogs", false,
+		"Whether to pull the archived binary logs needed to replay the changes performed after the target backup.")
+	restoreCommand.Flags().StringVar(&decryptPath, "decrypt-path", "",
+		"Directory path where the decrypted backup files are written. It requires an encryption key.")
+	restoreCommand.Flags().StringVar(&database, "database", "",
+		"Database to be restored, either from a per-database backup or from a backup containing all the databases. "+
+			"All the databases are restored when not provided.")
 }
 
 var restoreCommand = &cobra.Command{
@@ -48,28 +68,193 @@ var restoreCommand = &cobra.Command{
 			logger.Error(err, "error listing backup files")
 			os.Exit(1)
 		}
+		backupFileNames = backup.FilterBackupFiles(backupFileNames, physical)
 
-		backupTargetFile, err := backup.GetBackupTargetFile(backupFileNames, targetTime, logger.WithName("point-in-time-recovery"))
+		backupTargetFiles, err := backup.GetBackupTargetFiles(backupFileNames, targetTime, database,
+			logger.WithName("point-in-time-recovery"))
 		if err != nil {
 			logger.Error(err, "error reading getting target backup")
 			os.Exit(1)
 		}
-		logger.Info("obtained target backup", "file", backupTargetFile)
+		logger.Info("obtained target backups", "files", backupTargetFiles)
 
-		logger.Info("pulling target backup", "file", backupTargetFile)
-		if err := backupStorage.Pull(ctx, backupTargetFile); err != nil {
-			logger.Error(err, "error pulling target backup", "file", backupTargetFile)
-			os.Exit(1)
+		if s3Streaming {
+			if err := streamRestoreTargetBackup(ctx, backupStorage, backupTargetFiles); err != nil {
+				logger.Error(err, "error streaming target backup")
+				os.Exit(1)
+			}
+			return
+		}
+
+		var restoreTargetFiles []string
+		for _, backupTargetFile := range backupTargetFiles {
+			logger.Info("pulling target backup", "file", backupTargetFile)
+			if err := backupStorage.Pull(ctx, backupTargetFile); err != nil {
+				logger.Error(err, "error pulling target backup", "file", backupTargetFile)
+				os.Exit(1)
+			}
+
+			restoreTargetFile, err := prepareRestoreFile(backupTargetFile)
+			if err != nil {
+				logger.Error(err, "error preparing target backup", "file", backupTargetFile)
+				os.Exit(1)
+			}
+			restoreTargetFiles = append(restoreTargetFiles, restoreTargetFile)
+		}
+
+		if binlogs {
+			if len(backupTargetFiles) != 1 {
+				logger.Error(errors.New("binlogs can only be replayed on top of a single backup"), "error pulling binlogs")
+				os.Exit(1)
+			}
+			if err := pullBinlogs(ctx, backupTargetFiles[0]); err != nil {
+				logger.Error(err, "error pulling binlogs")
+				os.Exit(1)
+			}
 		}
 
 		logger.Info("writing target file", "path", targetFilePath)
-		if err := writeTargetFile(backupTargetFile); err != nil {
+		if err := writeTargetFile(strings.Join(restoreTargetFiles, "\n")); err != nil {
 			logger.Error(err, "error writing target file", "path", targetFilePath)
 			os.Exit(1)
 		}
 	},
 }
 
+// streamRestoreTargetBackup streams the target backup into the stream read by the restore container, which is only
+// completed once the backup has been verified against its manifest.
+func streamRestoreTargetBackup(ctx context.Context, backupStorage *backup.ManifestBackupStorage, backupTargetFiles []string) error {
+	if len(backupTargetFiles) != 1 {
+		return fmt.Errorf("a single target backup can be streamed, got %d", len(backupTargetFiles))
+	}
+	backupTargetFile := backupTargetFiles[0]
+	if backup.IsEncryptedBackupFile(backupTargetFile) || backup.IsMydumperBackupFile(backupTargetFile) {
+		return fmt.Errorf("streaming is not supported by encrypted nor mydumper backups: %s", backupTargetFile)
+	}
+
+	streamCompletePath := filepath.Join(path, backup.StreamCompleteFile)
+	if err := os.Remove(streamCompletePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error cleaning up previous stream: %v", err)
+	}
+	logger.Info("writing target file", "path", targetFilePath)
+	if err := writeTargetFile(backupTargetFile); err != nil {
+		return fmt.Errorf("error writing target file: %v", err)
+	}
+
+	reader, err := backupStorage.PullStream(ctx, backupTargetFile)
+	if err != nil {
+		return fmt.Errorf("error pulling target backup: %v", err)
+	}
+	defer reader.Close()
+
+	streamPath := filepath.Join(path, backup.StreamFile)
+	logger.Info("creating backup stream", "path", streamPath)
+	if err := backup.CreateStream(streamPath); err != nil {
+		return err
+	}
+	stream, err := os.OpenFile(streamPath, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("error opening backup stream: %v", err)
+	}
+	defer stream.Close()
+
+	logger.Info("streaming target backup", "file", backupTargetFile)
+	if _, err := io.Copy(stream, reader); err != nil {
+		return fmt.Errorf("error streaming target backup: %v", err)
+	}
+	if err := stream.Close(); err != nil {
+		return fmt.Errorf("error closing backup stream: %v", err)
+	}
+
+	logger.Info("completing backup stream", "file", backupTargetFile)
+	return os.WriteFile(streamCompletePath, nil, 0777)
+}
+
+func pullBinlogs(ctx context.Context, backupTargetFile string) error {
+	binlogStorage, err := getBinlogStorage()
+	if err != nil {
+		return fmt.Errorf("error getting binlog storage: %v", err)
+	}
+	if err := os.MkdirAll(getBinlogsPath(), 0777); err != nil {
+		return fmt.Errorf("error creating binlogs directory: %v", err)
+	}
+
+	binlogFileNames, err := binlogStorage.List(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing binlog files: %v", err)
+	}
+	manifest, err := backup.ReadManifest(filepath.Join(path, backup.ManifestFile(backupTargetFile)))
+	if err != nil {
+		return fmt.Errorf("error getting target backup manifest: %v", err)
+	}
+	if manifest.GTID == "" {
+		return fmt.Errorf("GTID position not found in target backup manifest: %s", backupTargetFile)
+	}
+	backupGtid, err := backup.ParseGtidPosition(manifest.GTID)
+	if err != nil {
+		return fmt.Errorf("error parsing target backup GTID position: %v", err)
+	}
+	logger.Info("obtained target backup GTID position", "gtid", backupGtid.String())
+
+	binlogFiles, err := backup.GetBinlogFilesForBackup(binlogFileNames, backupGtid, func(file string) (backup.GtidPosition, error) {
+		logger.Info("pulling binlog", "file", file)
+		if err := binlogStorage.Pull(ctx, file); err != nil {
+			return nil, fmt.Errorf("error pulling binlog: %v", err)
+		}
+		return backup.ReadBinlogGtidPosition(filepath.Join(getBinlogsPath(), file))
+	}, logger.WithName("binlogs"))
+	if err != nil {
+		return fmt.Errorf("error getting binlogs for backup: %v", err)
+	}
+	logger.Info("obtained binlogs to replay", "binlogs", len(binlogFiles))
+
+	binlogsTargetFilePath := filepath.Join(getBinlogsPath(), backup.BinlogsTargetFile)
+	logger.Info("writing binlogs target file", "path", binlogsTargetFilePath)
+	return os.WriteFile(binlogsTargetFilePath, []byte(strings.Join(binlogFiles, "\n")), 0777)
+}
+
+// prepareRestoreFile decrypts the backup file into the decrypt path, returning the name of the file to restore.
+// Unencrypted backup files are linked into the decrypt path, so old backups can still be restored.
+func prepareRestoreFile(backupTargetFile string) (string, error) {
+	if decryptPath == "" {
+		if backup.IsEncryptedBackupFile(backupTargetFile) {
+			return "", errors.New("backup file is encrypted, but decryption is not configured")
+		}
+		return backupTargetFile, nil
+	}
+	if encryptionKeyPath == "" {
+		return "", errors.New("encryption key must be provided in order to decrypt")
+	}
+	if err := os.MkdirAll(decryptPath, 0777); err != nil {
+		return "", fmt.Errorf("error creating decrypt directory: %v", err)
+	}
+
+	restoreFile := backup.DecryptedBackupFile(backupTargetFile)
+	src := filepath.Join(path, backupTargetFile)
+	dst := filepath.Join(decryptPath, restoreFile)
+	if err := os.Remove(dst); err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("error cleaning up decrypted file: %v", err)
+	}
+
+	if !backup.IsEncryptedBackupFile(backupTargetFile) {
+		logger.Info("target backup is not encrypted, linking it", "file", backupTargetFile)
+		if err := os.Symlink(src, dst); err != nil {
+			return "", fmt.Errorf("error linking backup file: %v", err)
+		}
+		return restoreFile, nil
+	}
+
+	key, err := backup.ReadEncryptionKey(encryptionKeyPath)
+	if err != nil {
+		return "", err
+	}
+	logger.Info("decrypting target backup", "file", backupTargetFile)
+	if err := backup.DecryptFile(src, dst, key); err != nil {
+		return "", fmt.Errorf("error decrypting backup file: %v", err)
+	}
+	return restoreFile, nil
+}
+
 func getTargetTime() (time.Time, error) {
 	if targetTimeRaw == "" {
 		return time.Now(), nil
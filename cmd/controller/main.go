@@ -14,6 +14,7 @@ import (
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/auth"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/batch"
+	"github.com/mariadb-operator/mariadb-operator/pkg/controller/certmanager"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/configmap"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/deployment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/endpoints"
@@ -168,6 +169,7 @@ var rootCmd = &cobra.Command{
 		authReconciler := auth.NewAuthReconciler(client, builder)
 		deployReconciler := deployment.NewDeploymentReconciler(client)
 		svcMonitorReconciler := servicemonitor.NewServiceMonitorReconciler(client)
+		certificateReconciler := certmanager.NewCertificateReconciler(client)
 
 		mxsReconciler := maxscale.NewMaxScaleReconciler(client, builder, env)
 		replConfig := replication.NewReplicationConfig(client, builder, secretReconciler)
@@ -239,6 +241,7 @@ var rootCmd = &cobra.Command{
 			AuthReconciler:           authReconciler,
 			DeploymentReconciler:     deployReconciler,
 			ServiceMonitorReconciler: svcMonitorReconciler,
+			CertificateReconciler:    certificateReconciler,
 
 			MaxScaleReconciler:    mxsReconciler,
 			ReplicationReconciler: replicationReconciler,
@@ -248,10 +251,11 @@ var rootCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if err = (&controller.MaxScaleReconciler{
-			Client:      client,
-			Scheme:      scheme,
-			Recorder:    mgr.GetEventRecorderFor("maxscale"),
-			RefResolver: refResolver,
+			Client:          client,
+			Scheme:          scheme,
+			Recorder:        mgr.GetEventRecorderFor("maxscale"),
+			RefResolver:     refResolver,
+			DiscoveryClient: discoveryClient,
 
 			Builder:        builder,
 			ConditionReady: conditionReady,
@@ -262,6 +266,7 @@ var rootCmd = &cobra.Command{
 			AuthReconciler:        authReconciler,
 			StatefulSetReconciler: statefulSetReconciler,
 			ServiceReconciler:     serviceReconciler,
+			CertificateReconciler: certificateReconciler,
 
 			SuspendEnabled: featureMaxScaleSuspend,
 
@@ -278,6 +283,8 @@ var rootCmd = &cobra.Command{
 			RefResolver:       refResolver,
 			ConditionComplete: conditionComplete,
 			BatchReconciler:   batchReconciler,
+			DiscoveryClient:   discoveryClient,
+			Recorder:          mgr.GetEventRecorderFor("backup"),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "Backup")
 			os.Exit(1)
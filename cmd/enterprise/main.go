@@ -14,6 +14,7 @@ import (
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/auth"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/batch"
+	"github.com/mariadb-operator/mariadb-operator/pkg/controller/certmanager"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/configmap"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/deployment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/endpoints"
@@ -179,6 +180,7 @@ var rootCmd = &cobra.Command{
 		rbacReconciler := rbac.NewRBACReconiler(client, builder)
 		deployReconciler := deployment.NewDeploymentReconciler(client)
 		svcMonitorReconciler := servicemonitor.NewServiceMonitorReconciler(client)
+		certificateReconciler := certmanager.NewCertificateReconciler(client)
 
 		mxsReconciler := maxscale.NewMaxScaleReconciler(client, builder, env)
 		replConfig := replication.NewReplicationConfig(client, builder, secretReconciler)
@@ -250,6 +252,7 @@ var rootCmd = &cobra.Command{
 			AuthReconciler:           authReconciler,
 			DeploymentReconciler:     deployReconciler,
 			ServiceMonitorReconciler: svcMonitorReconciler,
+			CertificateReconciler:    certificateReconciler,
 
 			MaxScaleReconciler:    mxsReconciler,
 			ReplicationReconciler: replicationReconciler,
@@ -263,16 +266,18 @@ var rootCmd = &cobra.Command{
 			Scheme:   scheme,
 			Recorder: mgr.GetEventRecorderFor("maxscale"),
 
-			Builder:        builder,
-			ConditionReady: conditionReady,
-			Environment:    env,
-			RefResolver:    refResolver,
+			Builder:         builder,
+			ConditionReady:  conditionReady,
+			Environment:     env,
+			RefResolver:     refResolver,
+			DiscoveryClient: discoveryClient,
 
 			SecretReconciler:      secretReconciler,
 			RBACReconciler:        rbacReconciler,
 			AuthReconciler:        authReconciler,
 			StatefulSetReconciler: statefulSetReconciler,
 			ServiceReconciler:     serviceReconciler,
+			CertificateReconciler: certificateReconciler,
 
 			SuspendEnabled: featureMaxScaleSuspend,
 
@@ -289,6 +294,7 @@ var rootCmd = &cobra.Command{
 			RefResolver:       refResolver,
 			ConditionComplete: conditionComplete,
 			BatchReconciler:   batchReconciler,
+			Recorder:          mgr.GetEventRecorderFor("backup"),
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "Backup")
 			os.Exit(1)
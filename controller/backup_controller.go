@@ -10,11 +10,14 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/batch"
+	"github.com/mariadb-operator/mariadb-operator/pkg/discovery"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metrics"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	batchv1 "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -27,20 +30,28 @@ type BackupReconciler struct {
 	RefResolver       *refresolver.RefResolver
 	ConditionComplete *condition.Complete
 	BatchReconciler   *batch.BatchReconciler
+	DiscoveryClient   *discovery.DiscoveryClient
+	Recorder          record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=mariadb.mmontes.io,resources=backups,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=mariadb.mmontes.io,resources=backups/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=mariadb.mmontes.io,resources=backups/finalizers,verbs=update
-//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=list;watch;create;patch
-//+kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=list;watch;create;patch
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=list;watch;create;patch;delete
+//+kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=list;watch;create;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=list;watch;create;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=list;watch;create;patch
+//+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;create;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	var backup mariadbv1alpha1.Backup
 	if err := r.Get(ctx, req.NamespacedName, &backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.DeleteBackup(req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
@@ -66,10 +77,18 @@ func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
+	if backup.IsVolumeSnapshot() {
+		return r.reconcileVolumeSnapshot(ctx, &backup, mariaDb)
+	}
+
 	var batchErr *multierror.Error
 	err = r.BatchReconciler.Reconcile(ctx, &backup, mariaDb)
 	batchErr = multierror.Append(batchErr, err)
 
+	if err := r.BatchReconciler.ReconcileBinlogArchive(ctx, &backup, mariaDb); err != nil {
+		batchErr = multierror.Append(batchErr, fmt.Errorf("error reconciling binlog archive: %v", err))
+	}
+
 	patcher, err := r.patcher(ctx, err, req.NamespacedName, &backup)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
@@ -81,9 +100,21 @@ func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	err = r.patchStatus(ctx, &backup, patcher)
 	batchErr = multierror.Append(batchErr, err)
 
+	if err := r.reconcileStatus(ctx, &backup); err != nil {
+		batchErr = multierror.Append(batchErr, fmt.Errorf("error reconciling status: %v", err))
+	}
+
+	if err := r.reconcileVerify(ctx, &backup, mariaDb); err != nil {
+		batchErr = multierror.Append(batchErr, fmt.Errorf("error reconciling verification: %v", err))
+	}
+
 	if err := batchErr.ErrorOrNil(); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error creating Job: %v", err)
 	}
+	if backup.Spec.Schedule != nil && backup.IsReplicaTarget() {
+		// The target replica is resolved periodically, as it may become unhealthy before the next scheduled Backup.
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
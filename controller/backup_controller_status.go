@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	backuppkg "github.com/mariadb-operator/mariadb-operator/pkg/backup"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metrics"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const backupCatalogContainer = "mariadb-operator"
+
+func (r *BackupReconciler) reconcileStatus(ctx context.Context, backup *mariadbv1alpha1.Backup) error {
+	var cronJob *batchv1.CronJob
+	if backup.Spec.Schedule != nil {
+		var existingCronJob batchv1.CronJob
+		if err := r.Get(ctx, client.ObjectKeyFromObject(backup), &existingCronJob); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		cronJob = &existingCronJob
+	}
+
+	job, err := r.getLastSuccessfulJob(ctx, backup, cronJob)
+	if err != nil {
+		return fmt.Errorf("error getting last successful Job: %v", err)
+	}
+	var catalog *backuppkg.Catalog
+	if job != nil {
+		catalog, err = r.getCatalog(ctx, job)
+		if err != nil {
+			log.FromContext(ctx).Info("error getting backup catalog", "err", err, "job", job.Name)
+			r.Recorder.Event(
+				backup,
+				corev1.EventTypeWarning,
+				mariadbv1alpha1.ReasonBackupCatalogInvalid,
+				fmt.Sprintf("Backup catalog could not be read from Job '%s': %v", job.Name, err),
+			)
+		}
+	}
+
+	patch := client.MergeFrom(backup.DeepCopy())
+	setBackupScheduleTimes(&backup.Status, cronJob, job)
+	if catalog != nil {
+		backup.Status.Backups = backupArtifacts(backup, catalog, job)
+	}
+	if err := r.Client.Status().Patch(ctx, backup, patch); err != nil {
+		return fmt.Errorf("error patching Backup status: %v", err)
+	}
+
+	metrics.SetBackupStatus(backup)
+	if catalog != nil {
+		metrics.SetBackupSummary(backup, catalog.Summary)
+	}
+	return nil
+}
+
+func (r *BackupReconciler) getLastSuccessfulJob(ctx context.Context, backup *mariadbv1alpha1.Backup,
+	cronJob *batchv1.CronJob) (*batchv1.Job, error) {
+	if cronJob == nil {
+		var job batchv1.Job
+		if err := r.Get(ctx, client.ObjectKeyFromObject(backup), &job); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if !isJobComplete(&job) {
+			return nil, nil
+		}
+		return &job, nil
+	}
+
+	var jobList batchv1.JobList
+	if err := r.List(ctx, &jobList, client.InNamespace(backup.Namespace)); err != nil {
+		return nil, err
+	}
+	var lastJob *batchv1.Job
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if !metav1.IsControlledBy(job, cronJob) || !isJobComplete(job) {
+			continue
+		}
+		if lastJob == nil || lastJob.Status.CompletionTime.Before(job.Status.CompletionTime) {
+			lastJob = job
+		}
+	}
+	return lastJob, nil
+}
+
+// getCatalog reads the backup catalog reported in the termination message of the Job Pods.
+func (r *BackupReconciler) getCatalog(ctx context.Context, job *batchv1.Job) (*backuppkg.Catalog, error) {
+	var podList corev1.PodList
+	if err := r.List(ctx, &podList, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return nil, fmt.Errorf("error listing Pods: %v", err)
+	}
+	for _, pod := range podList.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != backupCatalogContainer {
+				continue
+			}
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.ExitCode != 0 || terminated.Message == "" {
+				continue
+			}
+			return backuppkg.ParseCatalog(terminated.Message)
+		}
+	}
+	return nil, errors.New("catalog not found in Job Pods")
+}
+
+func setBackupScheduleTimes(status *mariadbv1alpha1.BackupStatus, cronJob *batchv1.CronJob, job *batchv1.Job) {
+	if cronJob != nil {
+		status.LastScheduleTime = cronJob.Status.LastScheduleTime
+		status.LastSuccessfulBackupTime = cronJob.Status.LastSuccessfulTime
+		return
+	}
+	if job != nil {
+		status.LastScheduleTime = job.Status.StartTime
+		status.LastSuccessfulBackupTime = job.Status.CompletionTime
+	}
+}
+
+// backupArtifacts converts the catalog into artifacts, keeping the details known from previous Jobs,
+// as the catalog only describes in detail the backup taken by the last Job.
+func backupArtifacts(backup *mariadbv1alpha1.Backup, catalog *backuppkg.Catalog,
+	job *batchv1.Job) []mariadbv1alpha1.BackupArtifact {
+	previousArtifacts := make(map[string]mariadbv1alpha1.BackupArtifact)
+	for _, artifact := range backup.Status.Backups {
+		previousArtifacts[artifact.FileName] = artifact
+	}
+
+	targets := make(map[string]bool)
+	for _, target := range catalog.Targets {
+		targets[target.FileName] = true
+	}
+
+	var artifacts []mariadbv1alpha1.BackupArtifact
+	for _, entry := range catalog.Backups {
+		artifact := mariadbv1alpha1.BackupArtifact{
+			FileName: entry.FileName,
+			Time:     metav1.NewTime(entry.Time),
+			Database: entry.Database,
+			Size:     entry.Size,
+			GTID:     entry.GTID,
+			Location: backup.FileLocation(entry.FileName),
+		}
+		if targets[entry.FileName] && job.Status.StartTime != nil && job.Status.CompletionTime != nil {
+			artifact.Duration = &metav1.Duration{
+				Duration: job.Status.CompletionTime.Sub(job.Status.StartTime.Time),
+			}
+		}
+		if previous, ok := previousArtifacts[entry.FileName]; ok {
+			if artifact.Size == 0 {
+				artifact.Size = previous.Size
+			}
+			if artifact.GTID == "" {
+				artifact.GTID = previous.GTID
+			}
+			if artifact.Duration == nil {
+				artifact.Duration = previous.Duration
+			}
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts
+}
+
+func isJobComplete(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobComplete && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -1,11 +1,17 @@
 package controller
 
 import (
+	"time"
+
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -130,5 +136,88 @@ var _ = Describe("Backup controller", func() {
 				return backup.IsComplete()
 			}, testTimeout, testInterval).Should(BeTrue())
 		})
+
+		It("Should verify the Backup", func() {
+			By("Creating Backup with verification")
+			backupKey := types.NamespacedName{
+				Name:      "backup-verify-test",
+				Namespace: testNamespace,
+			}
+			backup := testBackupWithS3Storage(backupKey, "test-backup")
+			backup.Spec.Verify = &mariadbv1alpha1.BackupVerify{
+				Enabled:  true,
+				SqlQuery: "SELECT 1;",
+			}
+			Expect(k8sClient.Create(testCtx, backup)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(testCtx, backup)).To(Succeed())
+			})
+
+			By("Expecting Backup to complete eventually")
+			Eventually(func() bool {
+				if err := k8sClient.Get(testCtx, backupKey, backup); err != nil {
+					return false
+				}
+				return backup.IsComplete() && backup.Status.LastSuccessfulBackupTime != nil
+			}, testTimeout, testInterval).Should(BeTrue())
+
+			var job batchv1.Job
+			By("Expecting to create a verify Job eventually")
+			Eventually(func() bool {
+				if err := k8sClient.Get(testCtx, backup.VerifyKey(), &job); err != nil {
+					return false
+				}
+				return true
+			}, testTimeout, testInterval).Should(BeTrue())
+
+			By("Expecting verify Job to target the last Backup")
+			Expect(job.Annotations).To(HaveKeyWithValue(
+				metadata.BackupTimeAnnotation,
+				backup.Status.LastSuccessfulBackupTime.UTC().Format(time.RFC3339),
+			))
+			Expect(metav1.IsControlledBy(&job, backup)).To(BeTrue())
+			Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+
+			By("Expecting verify Job to have mariadb-operator init container")
+			Expect(job.Spec.Template.Spec.InitContainers).To(ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Name": Equal("mariadb-operator"),
+				})))
+
+			By("Expecting verify Job to have mariadb container with the verification query")
+			Expect(job.Spec.Template.Spec.Containers).To(ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Name": Equal("mariadb"),
+					"Env": ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Value": Equal("SELECT 1;"),
+					})),
+				})))
+
+			By("Expecting Backup to be verified eventually")
+			Eventually(func() bool {
+				if err := k8sClient.Get(testCtx, backupKey, backup); err != nil {
+					return false
+				}
+				return meta.IsStatusConditionTrue(backup.Status.Conditions, mariadbv1alpha1.ConditionTypeVerified)
+			}, testTimeout, testInterval).Should(BeTrue())
+
+			verified := meta.FindStatusCondition(backup.Status.Conditions, mariadbv1alpha1.ConditionTypeVerified)
+			Expect(verified).ToNot(BeNil())
+			Expect(verified.Reason).To(Equal(mariadbv1alpha1.ConditionReasonJobComplete))
+
+			By("Disabling verification")
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(testCtx, backupKey, backup)).To(Succeed())
+				backup.Spec.Verify.Enabled = false
+				g.Expect(k8sClient.Update(testCtx, backup)).To(Succeed())
+				return true
+			}, testTimeout, testInterval).Should(BeTrue())
+
+			By("Expecting verify Job to be deleted eventually")
+			Eventually(func() bool {
+				err := k8sClient.Get(testCtx, backup.VerifyKey(), &job)
+				return apierrors.IsNotFound(err) || (err == nil && job.DeletionTimestamp != nil)
+			}, testTimeout, testInterval).Should(BeTrue())
+		})
 	})
 })
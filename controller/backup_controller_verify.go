@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileVerify restores every new Backup into an ephemeral MariaDB instance by means of a Job,
+// reporting the result in the Verified condition.
+func (r *BackupReconciler) reconcileVerify(ctx context.Context, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB) error {
+	key := backup.VerifyKey()
+	if !backup.IsVerifyEnabled() {
+		var existingJob batchv1.Job
+		if err := r.Get(ctx, key, &existingJob); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		return r.deleteVerifyJob(ctx, &existingJob)
+	}
+	if backup.Status.LastSuccessfulBackupTime == nil {
+		return nil
+	}
+
+	desiredJob, err := r.Builder.BuildBackupVerifyJob(key, backup, mariadb)
+	if err != nil {
+		return fmt.Errorf("error building verify Job: %v", err)
+	}
+
+	var existingJob batchv1.Job
+	if err := r.Get(ctx, key, &existingJob); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting verify Job: %v", err)
+		}
+		return r.createVerifyJob(ctx, backup, desiredJob)
+	}
+
+	if existingJob.Annotations[metadata.BackupTimeAnnotation] != desiredJob.Annotations[metadata.BackupTimeAnnotation] {
+		if existingJob.DeletionTimestamp != nil {
+			return nil
+		}
+		log.FromContext(ctx).Info("Verifying new backup", "job", key.Name)
+		return r.deleteVerifyJob(ctx, &existingJob)
+	}
+
+	return r.patchVerified(ctx, backup, &existingJob)
+}
+
+func (r *BackupReconciler) createVerifyJob(ctx context.Context, backup *mariadbv1alpha1.Backup, job *batchv1.Job) error {
+	if err := r.Create(ctx, job); err != nil {
+		return fmt.Errorf("error creating verify Job: %v", err)
+	}
+	return r.patchVerified(ctx, backup, job)
+}
+
+// deleteVerifyJob deletes the verify Job along with its Pods. The new Job will be created once the deletion is completed.
+func (r *BackupReconciler) deleteVerifyJob(ctx context.Context, job *batchv1.Job) error {
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return nil
+}
+
+func (r *BackupReconciler) patchVerified(ctx context.Context, backup *mariadbv1alpha1.Backup, job *batchv1.Job) error {
+	previousCondition := meta.FindStatusCondition(backup.Status.Conditions, mariadbv1alpha1.ConditionTypeVerified)
+
+	patch := client.MergeFrom(backup.DeepCopy())
+	condition.SetVerifiedWithJob(&backup.Status, job)
+	if err := r.Client.Status().Patch(ctx, backup, patch); err != nil {
+		return fmt.Errorf("error patching Backup status: %v", err)
+	}
+
+	currentCondition := meta.FindStatusCondition(backup.Status.Conditions, mariadbv1alpha1.ConditionTypeVerified)
+	if isVerifyFailed(currentCondition) && !isVerifyFailed(previousCondition) {
+		r.Recorder.Event(
+			backup,
+			corev1.EventTypeWarning,
+			mariadbv1alpha1.ReasonBackupVerifyFailed,
+			fmt.Sprintf("Backup taken at %s could not be verified. Check the logs of the Job '%s'",
+				backup.Status.LastSuccessfulBackupTime.UTC().Format(time.RFC3339), job.Name),
+		)
+	}
+	return nil
+}
+
+func isVerifyFailed(c *metav1.Condition) bool {
+	return c != nil && c.Reason == mariadbv1alpha1.ConditionReasonJobFailed
+}
@@ -0,0 +1,260 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
+	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metrics"
+	sqlClient "github.com/mariadb-operator/mariadb-operator/pkg/sql"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// volumeSnapshotLockTimeout bounds the time the tables are locked while taking a VolumeSnapshot.
+const volumeSnapshotLockTimeout = 1 * time.Minute
+
+// reconcileVolumeSnapshot takes CSI VolumeSnapshots of the storage PVC of the target Pod according to the schedule.
+// The tables are flushed and locked until the VolumeSnapshot has been cut, so it is consistent.
+func (r *BackupReconciler) reconcileVolumeSnapshot(ctx context.Context, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	exist, err := r.DiscoveryClient.VolumeSnapshotExist()
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error discovering VolumeSnapshot: %v", err)
+	}
+	if !exist {
+		r.Recorder.Event(backup, corev1.EventTypeWarning, mariadbv1alpha1.ReasonCRDNotFound,
+			"Unable to take Backup: VolumeSnapshot CRD not installed in the cluster")
+		if err := r.patchStatus(ctx, backup, r.ConditionComplete.PatcherFailed("VolumeSnapshot CRD not installed")); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	now := time.Now()
+	snapshotTime, requeueAfter, err := volumeSnapshotSchedule(backup, now)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting VolumeSnapshot schedule: %v", err)
+	}
+	if snapshotTime != nil {
+		if err := r.takeVolumeSnapshot(ctx, backup, mariadb, *snapshotTime); err != nil {
+			var snapshotErr *multierror.Error
+			snapshotErr = multierror.Append(snapshotErr, err)
+
+			err = r.patchStatus(ctx, backup, r.ConditionComplete.PatcherFailed("Error taking VolumeSnapshot"))
+			snapshotErr = multierror.Append(snapshotErr, err)
+
+			return ctrl.Result{}, fmt.Errorf("error taking VolumeSnapshot: %v", snapshotErr)
+		}
+		if err := r.cleanupVolumeSnapshots(ctx, backup, now); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error cleaning up VolumeSnapshots: %v", err)
+		}
+	}
+
+	if err := r.reconcileVolumeSnapshotStatus(ctx, backup); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error reconciling status: %v", err)
+	}
+	if len(backup.Status.VolumeSnapshots) > 0 && !backup.Status.VolumeSnapshots[0].ReadyToUse {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// volumeSnapshotSchedule returns the time when a VolumeSnapshot should be taken, if any,
+// and the time to wait until the next one is due.
+func volumeSnapshotSchedule(backup *mariadbv1alpha1.Backup, now time.Time) (*time.Time, time.Duration, error) {
+	lastScheduleTime := backup.Status.LastScheduleTime
+	if backup.Spec.Schedule == nil {
+		if lastScheduleTime == nil {
+			return &now, 0, nil
+		}
+		return nil, 0, nil
+	}
+	if backup.Spec.Schedule.Suspend {
+		return nil, 0, nil
+	}
+
+	last := backup.CreationTimestamp.Time
+	if lastScheduleTime != nil {
+		last = lastScheduleTime.Time
+	}
+	next, err := backup.Spec.Schedule.Next(last)
+	if err != nil {
+		return nil, 0, err
+	}
+	if now.Before(next) {
+		return nil, next.Sub(now), nil
+	}
+	next, err = backup.Spec.Schedule.Next(now)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &now, next.Sub(now), nil
+}
+
+func (r *BackupReconciler) takeVolumeSnapshot(ctx context.Context, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB, snapshotTime time.Time) error {
+	podIndex, err := r.BatchReconciler.BackupPodIndex(ctx, backup, mariadb)
+	if err != nil {
+		return fmt.Errorf("error getting Backup target: %v", err)
+	}
+	if podIndex == nil {
+		podIndex = ptr.To(ptr.Deref(mariadb.Status.CurrentPrimaryPodIndex, 0))
+	}
+	logger := log.FromContext(ctx).WithValues("pod-index", *podIndex)
+
+	mdbClient, err := sqlClient.NewInternalClientWithPodIndex(ctx, mariadb, r.RefResolver, *podIndex)
+	if err != nil {
+		return fmt.Errorf("error connecting to MariaDB: %v", err)
+	}
+	defer mdbClient.Close()
+
+	// The tables are locked until the VolumeSnapshot has been cut, for at most volumeSnapshotLockTimeout.
+	lockCtx, cancel := context.WithTimeout(ctx, volumeSnapshotLockTimeout)
+	defer cancel()
+
+	logger.V(1).Info("Locking tables")
+	lock, err := mdbClient.AcquireTablesReadLock(lockCtx)
+	if err != nil {
+		return fmt.Errorf("error locking tables: %v", err)
+	}
+	defer func() {
+		logger.V(1).Info("Unlocking tables")
+		if err := lock.Release(ctx); err != nil {
+			logger.Error(err, "error unlocking tables")
+		}
+	}()
+
+	key := backup.VolumeSnapshotKey(snapshotTime)
+	snapshot, err := r.Builder.BuildVolumeSnapshot(key, backup, mariadb, mariadb.StoragePVCKey(*podIndex).Name, snapshotTime)
+	if err != nil {
+		return fmt.Errorf("error building VolumeSnapshot: %v", err)
+	}
+	if err := r.Create(lockCtx, snapshot); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error creating VolumeSnapshot: %v", err)
+	}
+	if err := r.patchStatus(ctx, backup, func(c condition.Conditioner) {
+		backup.Status.LastScheduleTime = ptr.To(metav1.NewTime(snapshotTime))
+	}); err != nil {
+		return err
+	}
+
+	logger.Info("Waiting for VolumeSnapshot to be cut", "name", key.Name)
+	if err := wait.PollUntilContextCancel(lockCtx, 1*time.Second, true, func(ctx context.Context) (bool, error) {
+		var existingSnapshot unstructured.Unstructured
+		existingSnapshot.SetGroupVersionKind(builder.VolumeSnapshotGVK)
+		if err := r.Get(ctx, key, &existingSnapshot); err != nil {
+			return false, nil
+		}
+		creationTime, _, _ := unstructured.NestedString(existingSnapshot.Object, "status", "creationTime")
+		return creationTime != "", nil
+	}); err != nil {
+		return fmt.Errorf("error waiting for VolumeSnapshot to be cut: %v", err)
+	}
+	return nil
+}
+
+// cleanupVolumeSnapshots deletes the VolumeSnapshots older than the MaxRetention.
+func (r *BackupReconciler) cleanupVolumeSnapshots(ctx context.Context, backup *mariadbv1alpha1.Backup, now time.Time) error {
+	snapshots, err := r.listVolumeSnapshots(ctx, backup)
+	if err != nil {
+		return err
+	}
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		if now.Sub(volumeSnapshotTime(snapshot)) <= backup.Spec.MaxRetention.Duration {
+			continue
+		}
+		log.FromContext(ctx).Info("Deleting old VolumeSnapshot", "name", snapshot.GetName())
+		if err := r.Delete(ctx, snapshot); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting VolumeSnapshot: %v", err)
+		}
+	}
+	return nil
+}
+
+func (r *BackupReconciler) reconcileVolumeSnapshotStatus(ctx context.Context, backup *mariadbv1alpha1.Backup) error {
+	snapshots, err := r.listVolumeSnapshots(ctx, backup)
+	if err != nil {
+		return err
+	}
+	artifacts := volumeSnapshotArtifacts(snapshots, backup.CatalogLimitOrDefault())
+
+	if err := r.patchStatus(ctx, backup, func(c condition.Conditioner) {
+		backup.Status.VolumeSnapshots = artifacts
+		for _, artifact := range artifacts {
+			if artifact.ReadyToUse {
+				backup.Status.LastSuccessfulBackupTime = ptr.To(artifact.Time)
+				break
+			}
+		}
+		var latest *mariadbv1alpha1.BackupVolumeSnapshotArtifact
+		if len(artifacts) > 0 {
+			latest = &artifacts[0]
+		}
+		condition.SetCompleteWithVolumeSnapshot(c, latest)
+	}); err != nil {
+		return err
+	}
+
+	metrics.SetBackupStatus(backup)
+	metrics.SetBackupFiles(backup, len(snapshots))
+	return nil
+}
+
+func (r *BackupReconciler) listVolumeSnapshots(ctx context.Context, backup *mariadbv1alpha1.Backup) ([]unstructured.Unstructured, error) {
+	var snapshotList unstructured.UnstructuredList
+	snapshotList.SetGroupVersionKind(builder.VolumeSnapshotGVK.GroupVersion().WithKind(builder.VolumeSnapshotGVK.Kind + "List"))
+	if err := r.List(
+		ctx,
+		&snapshotList,
+		client.InNamespace(backup.Namespace),
+		client.MatchingLabels{metadata.BackupLabel: backup.Name},
+	); err != nil {
+		return nil, fmt.Errorf("error listing VolumeSnapshots: %v", err)
+	}
+	return snapshotList.Items, nil
+}
+
+// volumeSnapshotArtifacts converts the VolumeSnapshots into artifacts, sorted from newest to oldest.
+func volumeSnapshotArtifacts(snapshots []unstructured.Unstructured, limit int) []mariadbv1alpha1.BackupVolumeSnapshotArtifact {
+	artifacts := make([]mariadbv1alpha1.BackupVolumeSnapshotArtifact, 0, len(snapshots))
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		pvcName, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+		readyToUse, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+		artifacts = append(artifacts, mariadbv1alpha1.BackupVolumeSnapshotArtifact{
+			Name:                      snapshot.GetName(),
+			Time:                      metav1.NewTime(volumeSnapshotTime(snapshot)),
+			PersistentVolumeClaimName: pvcName,
+			ReadyToUse:                readyToUse,
+		})
+	}
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return artifacts[i].Time.After(artifacts[j].Time.Time)
+	})
+	if len(artifacts) > limit {
+		artifacts = artifacts[:limit]
+	}
+	return artifacts
+}
+
+func volumeSnapshotTime(snapshot *unstructured.Unstructured) time.Time {
+	if t, err := time.Parse(time.RFC3339, snapshot.GetAnnotations()[metadata.BackupTimeAnnotation]); err == nil {
+		return t
+	}
+	return snapshot.GetCreationTimestamp().Time
+}
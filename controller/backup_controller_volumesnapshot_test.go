@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestVolumeSnapshotSchedule(t *testing.T) {
+	creationTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newBackup := func(schedule *mariadbv1alpha1.Schedule, lastScheduleTime *time.Time) *mariadbv1alpha1.Backup {
+		backup := &mariadbv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "backup",
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(creationTime),
+			},
+			Spec: mariadbv1alpha1.BackupSpec{
+				Method:   mariadbv1alpha1.BackupMethodVolumeSnapshot,
+				Schedule: schedule,
+			},
+		}
+		if lastScheduleTime != nil {
+			backup.Status.LastScheduleTime = ptr.To(metav1.NewTime(*lastScheduleTime))
+		}
+		return backup
+	}
+	everySixHours := &mariadbv1alpha1.Schedule{
+		Cron: "0 */6 * * *",
+	}
+
+	tests := []struct {
+		name             string
+		backup           *mariadbv1alpha1.Backup
+		now              time.Time
+		wantSnapshot     bool
+		wantRequeueAfter time.Duration
+		wantErr          bool
+	}{
+		{
+			name:             "no schedule",
+			backup:           newBackup(nil, nil),
+			now:              creationTime.Add(time.Minute),
+			wantSnapshot:     true,
+			wantRequeueAfter: 0,
+		},
+		{
+			name:             "no schedule already taken",
+			backup:           newBackup(nil, ptr.To(creationTime.Add(time.Minute))),
+			now:              creationTime.Add(time.Hour),
+			wantSnapshot:     false,
+			wantRequeueAfter: 0,
+		},
+		{
+			name: "suspended",
+			backup: newBackup(&mariadbv1alpha1.Schedule{
+				Cron:    "0 */6 * * *",
+				Suspend: true,
+			}, nil),
+			now:              creationTime.Add(7 * time.Hour),
+			wantSnapshot:     false,
+			wantRequeueAfter: 0,
+		},
+		{
+			name:             "not due since creation",
+			backup:           newBackup(everySixHours, nil),
+			now:              creationTime.Add(time.Hour),
+			wantSnapshot:     false,
+			wantRequeueAfter: 5 * time.Hour,
+		},
+		{
+			name:             "due since creation",
+			backup:           newBackup(everySixHours, nil),
+			now:              creationTime.Add(6 * time.Hour),
+			wantSnapshot:     true,
+			wantRequeueAfter: 6 * time.Hour,
+		},
+		{
+			name:             "not due since last schedule",
+			backup:           newBackup(everySixHours, ptr.To(creationTime.Add(6*time.Hour))),
+			now:              creationTime.Add(10 * time.Hour),
+			wantSnapshot:     false,
+			wantRequeueAfter: 2 * time.Hour,
+		},
+		{
+			name:             "missed schedules",
+			backup:           newBackup(everySixHours, ptr.To(creationTime.Add(6*time.Hour))),
+			now:              creationTime.Add(25 * time.Hour),
+			wantSnapshot:     true,
+			wantRequeueAfter: 5 * time.Hour,
+		},
+		{
+			name: "invalid cron",
+			backup: newBackup(&mariadbv1alpha1.Schedule{
+				Cron: "foo",
+			}, nil),
+			now:     creationTime.Add(time.Hour),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshotTime, requeueAfter, err := volumeSnapshotSchedule(tt.backup, tt.now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expecting error to be non nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if tt.wantSnapshot {
+				if snapshotTime == nil || !snapshotTime.Equal(tt.now) {
+					t.Fatalf("expecting VolumeSnapshot to be taken at %v, got: %v", tt.now, snapshotTime)
+				}
+			} else if snapshotTime != nil {
+				t.Fatalf("expecting no VolumeSnapshot to be taken, got: %v", snapshotTime)
+			}
+			if requeueAfter != tt.wantRequeueAfter {
+				t.Fatalf("unexpected requeue after, expected: %v got: %v", tt.wantRequeueAfter, requeueAfter)
+			}
+		})
+	}
+}
+
+func TestCleanupVolumeSnapshots(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	backup := &mariadbv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "default",
+		},
+		Spec: mariadbv1alpha1.BackupSpec{
+			Method:       mariadbv1alpha1.BackupMethodVolumeSnapshot,
+			MaxRetention: metav1.Duration{Duration: 48 * time.Hour},
+		},
+	}
+	otherBackup := backup.DeepCopy()
+	otherBackup.Name = "other-backup"
+
+	r := &BackupReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(runtime.NewScheme()).
+			WithObjects(
+				testVolumeSnapshot(t, backup, now),
+				testVolumeSnapshot(t, backup, now.Add(-24*time.Hour)),
+				testVolumeSnapshot(t, backup, now.Add(-48*time.Hour)),
+				testVolumeSnapshot(t, backup, now.Add(-72*time.Hour)),
+				testVolumeSnapshot(t, backup, now.Add(-96*time.Hour)),
+				testVolumeSnapshot(t, otherBackup, now.Add(-96*time.Hour)),
+			).
+			Build(),
+	}
+	if err := r.cleanupVolumeSnapshots(context.Background(), backup, now); err != nil {
+		t.Fatalf("unexpected error cleaning up VolumeSnapshots: %v", err)
+	}
+
+	snapshots, err := r.listVolumeSnapshots(context.Background(), backup)
+	if err != nil {
+		t.Fatalf("unexpected error listing VolumeSnapshots: %v", err)
+	}
+	var names []string
+	for _, artifact := range volumeSnapshotArtifacts(snapshots, 10) {
+		names = append(names, artifact.Name)
+	}
+	wantNames := []string{
+		backup.VolumeSnapshotKey(now).Name,
+		backup.VolumeSnapshotKey(now.Add(-24 * time.Hour)).Name,
+		backup.VolumeSnapshotKey(now.Add(-48 * time.Hour)).Name,
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("unexpected VolumeSnapshots, expected: %v got: %v", wantNames, names)
+	}
+
+	var otherSnapshot unstructured.Unstructured
+	otherSnapshot.SetGroupVersionKind(builder.VolumeSnapshotGVK)
+	if err := r.Get(context.Background(), otherBackup.VolumeSnapshotKey(now.Add(-96*time.Hour)), &otherSnapshot); err != nil {
+		t.Fatalf("expecting VolumeSnapshot of other Backup to be kept, got error: %v", err)
+	}
+}
+
+func TestVolumeSnapshotArtifacts(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	backup := &mariadbv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "default",
+		},
+	}
+	ready := testVolumeSnapshot(t, backup, now.Add(-time.Hour))
+	if err := unstructured.SetNestedField(ready.Object, true, "status", "readyToUse"); err != nil {
+		t.Fatalf("unexpected error setting status: %v", err)
+	}
+	snapshots := []unstructured.Unstructured{
+		*ready,
+		*testVolumeSnapshot(t, backup, now.Add(-2*time.Hour)),
+		*testVolumeSnapshot(t, backup, now),
+	}
+
+	artifacts := volumeSnapshotArtifacts(snapshots, 2)
+	wantArtifacts := []mariadbv1alpha1.BackupVolumeSnapshotArtifact{
+		{
+			Name:                      backup.VolumeSnapshotKey(now).Name,
+			Time:                      metav1.NewTime(now),
+			PersistentVolumeClaimName: "storage-mariadb-1",
+			ReadyToUse:                false,
+		},
+		{
+			Name:                      backup.VolumeSnapshotKey(now.Add(-time.Hour)).Name,
+			Time:                      metav1.NewTime(now.Add(-time.Hour)),
+			PersistentVolumeClaimName: "storage-mariadb-1",
+			ReadyToUse:                true,
+		},
+	}
+	if !reflect.DeepEqual(artifacts, wantArtifacts) {
+		t.Fatalf("unexpected artifacts, expected: %v got: %v", wantArtifacts, artifacts)
+	}
+}
+
+func testVolumeSnapshot(t *testing.T, backup *mariadbv1alpha1.Backup, snapshotTime time.Time) *unstructured.Unstructured {
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetGroupVersionKind(builder.VolumeSnapshotGVK)
+	key := backup.VolumeSnapshotKey(snapshotTime)
+	snapshot.SetName(key.Name)
+	snapshot.SetNamespace(key.Namespace)
+	snapshot.SetLabels(map[string]string{
+		metadata.BackupLabel: backup.Name,
+	})
+	snapshot.SetAnnotations(map[string]string{
+		metadata.BackupTimeAnnotation: snapshotTime.UTC().Format(time.RFC3339),
+	})
+	if err := unstructured.SetNestedField(snapshot.Object, "storage-mariadb-1", "spec", "source",
+		"persistentVolumeClaimName"); err != nil {
+		t.Fatalf("unexpected error setting spec: %v", err)
+	}
+	return snapshot
+}
@@ -212,10 +212,21 @@ func (r *ConnectionReconciler) reconcileSecret(ctx context.Context, conn *mariad
 	if conn.Spec.Database != nil {
 		mdbOpts.Database = *conn.Spec.Database
 	}
+	healthCheckOpts := mdbOpts
+	if caSecretKeyRef, caNamespace := tlsCASecretKeyRef(refs); caSecretKeyRef != nil {
+		mdbOpts.Params = tlsParams(conn.Spec.Params)
+
+		caCert, err := r.RefResolver.SecretKeyRef(ctx, *caSecretKeyRef, caNamespace)
+		if err != nil {
+			return fmt.Errorf("error getting TLS CA for connection DSN: %v", err)
+		}
+		healthCheckOpts = mdbOpts
+		healthCheckOpts.TLSCACert = []byte(caCert)
+	}
 
 	var existingSecret corev1.Secret
 	if err := r.Get(ctx, key, &existingSecret); err == nil {
-		if err := r.healthCheck(ctx, conn, mdbOpts); err != nil {
+		if err := r.healthCheck(ctx, conn, healthCheckOpts); err != nil {
 			log.FromContext(ctx).Info("Error checking connection health", "err", err)
 			return errConnHealthCheck
 		}
@@ -294,6 +305,28 @@ func (r *ConnectionReconciler) reconcileSecret(ctx context.Context, conn *mariad
 	return nil
 }
 
+func tlsCASecretKeyRef(refs *mariadbv1alpha1.ConnectionRefs) (*corev1.SecretKeySelector, string) {
+	if refs.MariaDB != nil && refs.MariaDB.IsTLSEnabled() {
+		caSecretKeyRef := refs.MariaDB.TLSServerCASecretKeyRef()
+		return &caSecretKeyRef, refs.MariaDB.Namespace
+	}
+	if refs.MaxScale != nil && refs.MaxScale.IsTLSEnabled() {
+		caSecretKeyRef := refs.MaxScale.TLSCASecretKeyRef()
+		return &caSecretKeyRef, refs.MaxScale.Namespace
+	}
+	return nil, ""
+}
+
+func tlsParams(params map[string]string) map[string]string {
+	tlsParams := map[string]string{
+		"tls": "true",
+	}
+	for k, v := range params {
+		tlsParams[k] = v
+	}
+	return tlsParams
+}
+
 func (r *ConnectionReconciler) healthCheck(ctx context.Context, conn *mariadbv1alpha1.Connection, clientOpts clientsql.Opts) error {
 	if conn.Spec.HealthCheck == nil {
 		return nil
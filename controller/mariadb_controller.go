@@ -12,6 +12,7 @@ import (
 	labels "github.com/mariadb-operator/mariadb-operator/pkg/builder/labels"
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/auth"
+	"github.com/mariadb-operator/mariadb-operator/pkg/controller/certmanager"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/configmap"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/deployment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/endpoints"
@@ -28,6 +29,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/health"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
+	sqlClient "github.com/mariadb-operator/mariadb-operator/pkg/sql"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -64,6 +66,7 @@ type MariaDBReconciler struct {
 	AuthReconciler           *auth.AuthReconciler
 	DeploymentReconciler     *deployment.DeploymentReconciler
 	ServiceMonitorReconciler *servicemonitor.ServiceMonitorReconciler
+	CertificateReconciler    *certmanager.CertificateReconciler
 	MaxScaleReconciler       *maxscale.MaxScaleReconciler
 
 	ReplicationReconciler *replication.ReplicationReconciler
@@ -96,6 +99,7 @@ type patcherMariaDB func(*mariadbv1alpha1.MariaDBStatus) error
 //+kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 //+kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
 //+kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=list;watch;create;patch
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -118,6 +122,10 @@ func (r *MariaDBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			Name:      "Secret",
 			Reconcile: r.reconcileSecret,
 		},
+		{
+			Name:      "TLS",
+			Reconcile: r.reconcileTLS,
+		},
 		{
 			Name:      "ConfigMap",
 			Reconcile: r.reconcileConfigMap,
@@ -126,6 +134,14 @@ func (r *MariaDBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			Name:      "RBAC",
 			Reconcile: r.reconcileRBAC,
 		},
+		{
+			Name:      "PhysicalRestore",
+			Reconcile: r.reconcilePhysicalRestore,
+		},
+		{
+			Name:      "VolumeSnapshotStorage",
+			Reconcile: r.reconcileVolumeSnapshotStorage,
+		},
 		{
 			Name:      "StatefulSet",
 			Reconcile: r.reconcileStatefulSet,
@@ -150,6 +166,10 @@ func (r *MariaDBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			Name:      "Galera",
 			Reconcile: r.reconcileGalera,
 		},
+		{
+			Name:      "TLSReload",
+			Reconcile: r.reconcileTLSReload,
+		},
 		{
 			Name:      "Restore",
 			Reconcile: r.reconcileRestore,
@@ -256,6 +276,11 @@ func (r *MariaDBReconciler) reconcileConfigMap(ctx context.Context, mariadb *mar
 			return ctrl.Result{}, err
 		}
 	}
+	if mariadb.IsGaleraTLSEnabled() {
+		if err := r.GaleraReconciler.ReconcileConfigMap(ctx, mariadb); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -361,6 +386,10 @@ func (r *MariaDBReconciler) reconcileRestore(ctx context.Context, mdb *mariadbv1
 		return ctrl.Result{}, nil
 	}
 
+	if mdb.Spec.BootstrapFrom.IsVolumeSnapshot() {
+		return ctrl.Result{}, r.reconcileVolumeSnapshotRestore(ctx, mdb)
+	}
+
 	var existingRestore mariadbv1alpha1.Restore
 	if err := r.Get(ctx, mdb.RestoreKey(), &existingRestore); err == nil {
 		return ctrl.Result{}, nil
@@ -380,6 +409,126 @@ func (r *MariaDBReconciler) reconcileRestore(ctx context.Context, mdb *mariadbv1
 	return ctrl.Result{}, r.Create(ctx, restore)
 }
 
+// reconcilePhysicalRestore copies back a physical backup into the datadir of the first Pod before the StatefulSet is created.
+func (r *MariaDBReconciler) reconcilePhysicalRestore(ctx context.Context, mdb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	if mdb.Spec.BootstrapFrom == nil {
+		return ctrl.Result{}, nil
+	}
+	if mdb.HasRestoredBackup() {
+		return ctrl.Result{}, nil
+	}
+	var existingSts appsv1.StatefulSet
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mdb), &existingSts); err == nil {
+		return ctrl.Result{}, nil
+	}
+	physical, err := r.isPhysicalBootstrap(ctx, mdb)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting bootstrap method: %v", err)
+	}
+	if !physical {
+		return ctrl.Result{}, nil
+	}
+
+	var existingRestore mariadbv1alpha1.Restore
+	if err := r.Get(ctx, mdb.RestoreKey(), &existingRestore); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting restore: %v", err)
+		}
+		if err := r.patchStatus(ctx, mdb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+			condition.SetRestoringBackup(status)
+			return nil
+		}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error patching status: %v", err)
+		}
+
+		restore, err := r.Builder.BuildRestore(mdb, mdb.RestoreKey())
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error building restore: %v", err)
+		}
+		if err := r.Create(ctx, restore); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error creating restore: %v", err)
+		}
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	if !existingRestore.IsComplete() {
+		log.FromContext(ctx).V(1).Info("Physical restore not complete. Requeuing")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	return ctrl.Result{}, r.patchStatus(ctx, mdb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+		condition.SetRestoredBackup(status)
+		return nil
+	})
+}
+
+// reconcileVolumeSnapshotStorage creates the storage PVCs from the VolumeSnapshot before the StatefulSet is created.
+// The data source is not part of the StatefulSet volumeClaimTemplates, so the PVCs created afterwards, for instance
+// when scaling up, are not provisioned from the VolumeSnapshot.
+func (r *MariaDBReconciler) reconcileVolumeSnapshotStorage(ctx context.Context, mdb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	if mdb.Spec.BootstrapFrom == nil || !mdb.Spec.BootstrapFrom.IsVolumeSnapshot() {
+		return ctrl.Result{}, nil
+	}
+	if mdb.HasRestoredBackup() {
+		return ctrl.Result{}, nil
+	}
+	var existingSts appsv1.StatefulSet
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mdb), &existingSts); err == nil {
+		return ctrl.Result{}, nil
+	}
+
+	for i := 0; i < int(mdb.Spec.Replicas); i++ {
+		key := mdb.StoragePVCKey(i)
+		var existingPvc corev1.PersistentVolumeClaim
+		if err := r.Get(ctx, key, &existingPvc); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting PersistentVolumeClaim: %v", err)
+		}
+
+		pvc, err := r.Builder.BuildStoragePVC(key, mdb)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error building storage PVC: %v", err)
+		}
+		if err := r.Create(ctx, pvc); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error creating storage PVC: %v", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileVolumeSnapshotRestore finishes the bootstrap from a VolumeSnapshot, which has already been restored
+// by provisioning the storage PVCs from it. The replication configuration of the source, if any, is reset.
+func (r *MariaDBReconciler) reconcileVolumeSnapshotRestore(ctx context.Context, mdb *mariadbv1alpha1.MariaDB) error {
+	podIndex := ptr.Deref(mdb.Status.CurrentPrimaryPodIndex, 0)
+	mdbClient, err := sqlClient.NewInternalClientWithPodIndex(ctx, mdb, r.RefResolver, podIndex)
+	if err != nil {
+		return fmt.Errorf("error connecting to MariaDB: %v", err)
+	}
+	defer mdbClient.Close()
+
+	if err := mdbClient.StopAllSlaves(ctx); err != nil {
+		return fmt.Errorf("error stopping replicas: %v", err)
+	}
+	if err := mdbClient.ResetAllSlaves(ctx); err != nil {
+		return fmt.Errorf("error resetting replicas: %v", err)
+	}
+	return r.patchStatus(ctx, mdb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+		condition.SetRestoredBackup(status)
+		return nil
+	})
+}
+
+func (r *MariaDBReconciler) isPhysicalBootstrap(ctx context.Context, mdb *mariadbv1alpha1.MariaDB) (bool, error) {
+	if mdb.Spec.BootstrapFrom.BackupRef != nil {
+		backup, err := r.RefResolver.Backup(ctx, mdb.Spec.BootstrapFrom.BackupRef, mdb.Namespace)
+		if err != nil {
+			return false, fmt.Errorf("error getting Backup: %v", err)
+		}
+		return backup.IsPhysical(), nil
+	}
+	return mdb.Spec.BootstrapFrom.IsPhysical(), nil
+}
+
 func (r *MariaDBReconciler) reconcileDefaultPDB(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) error {
 	if mariadb.Spec.PodDisruptionBudget == nil {
 		return nil
@@ -0,0 +1,333 @@
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
+	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
+	certctrl "github.com/mariadb-operator/mariadb-operator/pkg/controller/certificate"
+	"github.com/mariadb-operator/mariadb-operator/pkg/health"
+	"github.com/mariadb-operator/mariadb-operator/pkg/pki"
+	"github.com/mariadb-operator/mariadb-operator/pkg/sql"
+	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (r *MariaDBReconciler) reconcileTLS(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	if !mariadb.IsTLSEnabled() {
+		return ctrl.Result{}, nil
+	}
+	if mariadb.IsTLSIssuedByOperator() {
+		result, err := r.tlsCertReconciler(mariadb).Reconcile(ctx)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error reconciling TLS certificates: %v", err)
+		}
+		if mariadb.Status.TLS != nil {
+			if result.RefreshedCA {
+				r.Recorder.Event(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonTLSCertificateRenewed,
+					"CA certificate renewed")
+			}
+			if result.RefreshedCert {
+				r.Recorder.Event(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonTLSCertificateRenewed,
+					"Server certificate renewed")
+			}
+		}
+	}
+	if mariadb.IsTLSIssuedByCertManager() {
+		if result, err := r.reconcileCertManagerCertificate(ctx, mariadb); !result.IsZero() || err != nil {
+			return result, err
+		}
+	}
+	if err := r.reconcileTLSStatus(ctx, mariadb); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error reconciling TLS status: %v", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *MariaDBReconciler) reconcileCertManagerCertificate(ctx context.Context,
+	mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	exist, err := r.DiscoveryClient.CertificateExist()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !exist {
+		r.Recorder.Event(mariadb, corev1.EventTypeWarning, mariadbv1alpha1.ReasonCRDNotFound,
+			"Unable to reconcile TLS: Certificate CRD not installed in the cluster")
+		log.FromContext(ctx).Error(errors.New("Certificate CRD not installed in the cluster"), "Unable to reconcile TLS")
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	certDNSNames := mariadbDNSNames(mariadb)
+	certValidity := certctrl.DefaultCertValidity
+	if mariadb.Spec.TLS.CertValidity != nil {
+		certValidity = mariadb.Spec.TLS.CertValidity.Duration
+	}
+	lookaheadValidity := tlsLookaheadValidity(mariadb)
+	opts := builder.CertificateOpts{
+		MariaDB:     mariadb,
+		Key:         mariadb.TLSServerCertSecretKey(),
+		SecretName:  mariadb.TLSServerCertSecretRef().Name,
+		CommonName:  certDNSNames.CommonName,
+		DNSNames:    certDNSNames.Names,
+		IssuerRef:   *mariadb.Spec.TLS.IssuerRef,
+		Duration:    &certValidity,
+		RenewBefore: &lookaheadValidity,
+	}
+	desiredCert, err := r.Builder.BuildCertificate(opts, mariadb)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error building Certificate: %v", err)
+	}
+	if err := r.CertificateReconciler.Reconcile(ctx, desiredCert); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error reconciling Certificate: %v", err)
+	}
+
+	var secret corev1.Secret
+	if err := r.Get(ctx, mariadb.TLSServerCertSecretKey(), &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).V(1).Info("Certificate Secret not issued yet. Requeuing")
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("error getting Certificate Secret: %v", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *MariaDBReconciler) reconcileTLSStatus(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) error {
+	caCertPEM, err := r.RefResolver.SecretKeyRef(ctx, mariadb.TLSServerCASecretKeyRef(), mariadb.Namespace)
+	if err != nil {
+		return fmt.Errorf("error getting CA certificate: %v", err)
+	}
+	caCert, err := pki.ParseCert([]byte(caCertPEM))
+	if err != nil {
+		return fmt.Errorf("error parsing CA certificate: %v", err)
+	}
+
+	certSecretKeyRef := corev1.SecretKeySelector{
+		LocalObjectReference: mariadb.TLSServerCertSecretRef(),
+		Key:                  corev1.TLSCertKey,
+	}
+	certPEM, err := r.RefResolver.SecretKeyRef(ctx, certSecretKeyRef, mariadb.Namespace)
+	if err != nil {
+		return fmt.Errorf("error getting server certificate: %v", err)
+	}
+	cert, err := pki.ParseCert([]byte(certPEM))
+	if err != nil {
+		return fmt.Errorf("error parsing server certificate: %v", err)
+	}
+
+	lookaheadTime := time.Now().Add(tlsLookaheadValidity(mariadb))
+	var expiring []string
+	if caCert.NotAfter.Before(lookaheadTime) {
+		expiring = append(expiring, fmt.Sprintf("CA certificate expires at %s", caCert.NotAfter.Format(time.RFC3339)))
+	}
+	if cert.NotAfter.Before(lookaheadTime) {
+		expiring = append(expiring, fmt.Sprintf("server certificate expires at %s", cert.NotAfter.Format(time.RFC3339)))
+	}
+
+	return r.patchStatus(ctx, mariadb, func(status *mariadbv1alpha1.MariaDBStatus) error {
+		status.TLS = &mariadbv1alpha1.MariaDBTLSStatus{
+			CACertNotAfter:     &metav1.Time{Time: caCert.NotAfter},
+			ServerCertNotAfter: &metav1.Time{Time: cert.NotAfter},
+		}
+		if len(expiring) > 0 {
+			condition.SetTLSCertificatesExpiring(status, strings.Join(expiring, ", "))
+		} else {
+			condition.SetTLSCertificatesValid(status)
+		}
+		return nil
+	})
+}
+
+func (r *MariaDBReconciler) reconcileTLSReload(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (ctrl.Result, error) {
+	if !mariadb.IsTLSEnabled() || !mariadb.IsReady() || mariadb.Status.TLS == nil || mariadb.Status.TLS.ServerCertNotAfter == nil {
+		return ctrl.Result{}, nil
+	}
+	healthy, err := health.IsStatefulSetHealthy(ctx, r.Client, client.ObjectKeyFromObject(mariadb))
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error checking StatefulSet health: %v", err)
+	}
+	if !healthy {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	certNotAfter := mariadb.Status.TLS.ServerCertNotAfter.Time
+	for _, podIndex := range tlsReloadOrder(mariadb) {
+		if result, err := r.reloadTLSPod(ctx, mariadb, podIndex, certNotAfter); !result.IsZero() || err != nil {
+			return result, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *MariaDBReconciler) reloadTLSPod(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB, podIndex int,
+	certNotAfter time.Time) (ctrl.Result, error) {
+	podName := statefulset.PodName(mariadb.ObjectMeta, podIndex)
+	logger := log.FromContext(ctx).WithName("tls").WithValues("pod", podName)
+
+	sqlClient, err := sql.NewInternalClientWithPodIndex(ctx, mariadb, r.RefResolver, podIndex)
+	if err != nil {
+		if isCertVerificationError(err) {
+			logger.Info("Unable to verify TLS certificate. Restarting Pod", "err", err)
+			return r.restartTLSPod(ctx, mariadb, podName)
+		}
+		return ctrl.Result{}, fmt.Errorf("error connecting to Pod '%s': %v", podName, err)
+	}
+	defer sqlClient.Close()
+
+	loaded, err := isTLSCertLoaded(ctx, sqlClient, certNotAfter)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error checking TLS certificate in Pod '%s': %v", podName, err)
+	}
+	if loaded {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Reloading TLS certificate")
+	if err := sqlClient.FlushSSL(ctx); err != nil {
+		if errors.Is(err, sql.ErrFlushSSLUnsupported) {
+			logger.Info("FLUSH SSL not supported. Restarting Pod")
+			return r.restartTLSPod(ctx, mariadb, podName)
+		}
+		return ctrl.Result{}, fmt.Errorf("error reloading TLS certificate in Pod '%s': %v", podName, err)
+	}
+	if mariadb.IsGaleraTLSEnabled() {
+		if err := sqlClient.ReloadGaleraSSL(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error reloading Galera TLS certificate in Pod '%s': %v", podName, err)
+		}
+	}
+
+	loaded, err = isTLSCertLoaded(ctx, sqlClient, certNotAfter)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error checking TLS certificate in Pod '%s': %v", podName, err)
+	}
+	if !loaded {
+		logger.V(1).Info("TLS certificate not mounted yet. Requeuing")
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	r.Recorder.Eventf(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonTLSCertificateReloaded,
+		"Pod '%s' reloaded TLS certificate", podName)
+	return ctrl.Result{}, nil
+}
+
+func (r *MariaDBReconciler) restartTLSPod(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB,
+	podName string) (ctrl.Result, error) {
+	r.Recorder.Eventf(mariadb, corev1.EventTypeNormal, mariadbv1alpha1.ReasonTLSCertificateRestart,
+		"Restarting Pod '%s' to load TLS certificate", podName)
+
+	pod := corev1.Pod{}
+	key := types.NamespacedName{
+		Name:      podName,
+		Namespace: mariadb.Namespace,
+	}
+	if err := r.Get(ctx, key, &pod); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error getting Pod '%s': %v", podName, err)
+	}
+	if err := r.Delete(ctx, &pod); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting Pod '%s': %v", podName, err)
+	}
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+func (r *MariaDBReconciler) tlsCertReconciler(mariadb *mariadbv1alpha1.MariaDB) *certctrl.CertReconciler {
+	certDNSNames := mariadbDNSNames(mariadb)
+	opts := []certctrl.CertReconcilerOpt{
+		certctrl.WithOwnerReferences(
+			*metav1.NewControllerRef(mariadb, mariadbv1alpha1.GroupVersion.WithKind("MariaDB")),
+		),
+		certctrl.WithLookaheadValidity(tlsLookaheadValidity(mariadb)),
+	}
+	if mariadb.Spec.TLS.CAValidity != nil {
+		opts = append(opts, certctrl.WithCAValidity(mariadb.Spec.TLS.CAValidity.Duration))
+	}
+	if mariadb.Spec.TLS.CertValidity != nil {
+		opts = append(opts, certctrl.WithCertValidity(mariadb.Spec.TLS.CertValidity.Duration))
+	}
+	if mariadb.IsGaleraTLSEnabled() {
+		// Galera peers authenticate each other, so the server certificate is also presented as client certificate.
+		opts = append(opts, certctrl.WithCertClientAuth())
+	}
+
+	return certctrl.NewCertReconciler(
+		r.Client,
+		mariadb.TLSCASecretKey(),
+		fmt.Sprintf("%s-ca", mariadb.Name),
+		mariadb.TLSServerCertSecretKey(),
+		certDNSNames.CommonName,
+		certDNSNames.Names,
+		opts...,
+	)
+}
+
+func tlsLookaheadValidity(mariadb *mariadbv1alpha1.MariaDB) time.Duration {
+	if mariadb.Spec.TLS != nil && mariadb.Spec.TLS.LookaheadValidity != nil {
+		return mariadb.Spec.TLS.LookaheadValidity.Duration
+	}
+	return certctrl.DefaultLookaheadValidity
+}
+
+// tlsReloadOrder returns the Pod indexes in the order they should reload the TLS certificate,
+// following the StatefulSet rolling update order and leaving the primary for the end.
+func tlsReloadOrder(mariadb *mariadbv1alpha1.MariaDB) []int {
+	var indexes []int
+	primaryIndex := mariadb.Status.CurrentPrimaryPodIndex
+	for i := int(mariadb.Spec.Replicas) - 1; i >= 0; i-- {
+		if primaryIndex != nil && *primaryIndex == i {
+			continue
+		}
+		indexes = append(indexes, i)
+	}
+	if primaryIndex != nil {
+		indexes = append(indexes, *primaryIndex)
+	}
+	return indexes
+}
+
+func isTLSCertLoaded(ctx context.Context, client *sql.Client, certNotAfter time.Time) (bool, error) {
+	notAfter, err := client.SSLServerNotAfter(ctx)
+	if err != nil {
+		return false, err
+	}
+	return isSameCertNotAfter(*notAfter, certNotAfter), nil
+}
+
+// isSameCertNotAfter compares the expiration dates with second precision, as printed by OpenSSL.
+func isSameCertNotAfter(notAfter, certNotAfter time.Time) bool {
+	return notAfter.Unix() == certNotAfter.Unix()
+}
+
+func isCertVerificationError(err error) bool {
+	var certErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	return errors.As(err, &certErr) || errors.As(err, &authorityErr)
+}
+
+func mariadbDNSNames(mariadb *mariadbv1alpha1.MariaDB) *dnsNames {
+	names := serviceDNSNames(client.ObjectKeyFromObject(mariadb))
+	if mariadb.IsHAEnabled() {
+		names.Names = append(names.Names, serviceDNSNames(mariadb.PrimaryServiceKey()).Names...)
+		names.Names = append(names.Names, serviceDNSNames(mariadb.SecondaryServiceKey()).Names...)
+	}
+	internalSvcKey := mariadb.InternalServiceKey()
+	names.Names = append(names.Names, serviceDNSNames(internalSvcKey).Names...)
+	names.Names = append(names.Names,
+		fmt.Sprintf("*.%s", statefulset.ServiceFQDNWithService(mariadb.ObjectMeta, internalSvcKey.Name)),
+		fmt.Sprintf("*.%s.%s.svc", internalSvcKey.Name, internalSvcKey.Namespace),
+		fmt.Sprintf("*.%s.%s", internalSvcKey.Name, internalSvcKey.Namespace),
+		fmt.Sprintf("*.%s", internalSvcKey.Name),
+		"localhost",
+	)
+	return names
+}
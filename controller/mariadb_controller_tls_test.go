@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"k8s.io/utils/ptr"
+)
+
+func TestTLSReloadOrder(t *testing.T) {
+	newMariaDB := func(replicas int32, primaryIndex *int) *mariadbv1alpha1.MariaDB {
+		return &mariadbv1alpha1.MariaDB{
+			Spec: mariadbv1alpha1.MariaDBSpec{
+				Replicas: replicas,
+			},
+			Status: mariadbv1alpha1.MariaDBStatus{
+				CurrentPrimaryPodIndex: primaryIndex,
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		mariadb     *mariadbv1alpha1.MariaDB
+		wantIndexes []int
+	}{
+		{
+			name:        "single replica",
+			mariadb:     newMariaDB(1, ptr.To(0)),
+			wantIndexes: []int{0},
+		},
+		{
+			name:        "no primary",
+			mariadb:     newMariaDB(3, nil),
+			wantIndexes: []int{2, 1, 0},
+		},
+		{
+			name:        "first primary",
+			mariadb:     newMariaDB(3, ptr.To(0)),
+			wantIndexes: []int{2, 1, 0},
+		},
+		{
+			name:        "middle primary",
+			mariadb:     newMariaDB(3, ptr.To(1)),
+			wantIndexes: []int{2, 0, 1},
+		},
+		{
+			name:        "last primary",
+			mariadb:     newMariaDB(3, ptr.To(2)),
+			wantIndexes: []int{1, 0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if indexes := tlsReloadOrder(tt.mariadb); !reflect.DeepEqual(indexes, tt.wantIndexes) {
+				t.Errorf("unexpected indexes, got: %v expected: %v", indexes, tt.wantIndexes)
+			}
+		})
+	}
+}
+
+func TestIsSameCertNotAfter(t *testing.T) {
+	certNotAfter := time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		wantSame bool
+	}{
+		{
+			name:     "same",
+			notAfter: certNotAfter,
+			wantSame: true,
+		},
+		{
+			name:     "sub-second difference",
+			notAfter: certNotAfter.Add(500 * time.Millisecond),
+			wantSame: true,
+		},
+		{
+			name:     "different location",
+			notAfter: certNotAfter.In(time.FixedZone("CET", 3600)),
+			wantSame: true,
+		},
+		{
+			name:     "previous cert",
+			notAfter: certNotAfter.Add(-24 * time.Hour),
+			wantSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if same := isSameCertNotAfter(tt.notAfter, certNotAfter); same != tt.wantSame {
+				t.Errorf("unexpected result, got: %v expected: %v", same, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestIsCertVerificationError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			err:     nil,
+			wantErr: false,
+		},
+		{
+			name:    "generic error",
+			err:     errors.New("connection refused"),
+			wantErr: false,
+		},
+		{
+			name:    "unknown authority",
+			err:     x509.UnknownAuthorityError{},
+			wantErr: true,
+		},
+		{
+			name:    "wrapped unknown authority",
+			err:     fmt.Errorf("error connecting: %w", x509.UnknownAuthorityError{}),
+			wantErr: true,
+		},
+		{
+			name: "certificate verification",
+			err: &tls.CertificateVerificationError{
+				Err: x509.CertificateInvalidError{Reason: x509.Expired},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if isErr := isCertVerificationError(tt.err); isErr != tt.wantErr {
+				t.Errorf("unexpected result, got: %v expected: %v", isErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -24,11 +24,13 @@ import (
 	labels "github.com/mariadb-operator/mariadb-operator/pkg/builder/labels"
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/auth"
+	"github.com/mariadb-operator/mariadb-operator/pkg/controller/certmanager"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/rbac"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/secret"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/service"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/statefulset"
 	ds "github.com/mariadb-operator/mariadb-operator/pkg/datastructures"
+	"github.com/mariadb-operator/mariadb-operator/pkg/discovery"
 	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
 	mxsclient "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/client"
 	mxsconfig "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/config"
@@ -43,16 +45,18 @@ type MaxScaleReconciler struct {
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
 
-	Builder        *builder.Builder
-	ConditionReady *condition.Ready
-	Environment    *environment.Environment
-	RefResolver    *refresolver.RefResolver
+	Builder         *builder.Builder
+	ConditionReady  *condition.Ready
+	Environment     *environment.Environment
+	RefResolver     *refresolver.RefResolver
+	DiscoveryClient *discovery.DiscoveryClient
 
 	SecretReconciler      *secret.SecretReconciler
 	RBACReconciler        *rbac.RBACReconciler
 	AuthReconciler        *auth.AuthReconciler
 	StatefulSetReconciler *statefulset.StatefulSetReconciler
 	ServiceReconciler     *service.ServiceReconciler
+	CertificateReconciler *certmanager.CertificateReconciler
 
 	SuspendEnabled bool
 
@@ -82,6 +86,7 @@ type reconcilePhaseMaxScale struct {
 //+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=list;watch;create;patch
 //+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=list;watch;create;patch
 //+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=list;watch;create;patch
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -120,6 +125,10 @@ func (r *MaxScaleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			name:      "ServiceAccount",
 			reconcile: r.reconcileServiceAccount,
 		},
+		{
+			name:      "TLS",
+			reconcile: r.reconcileTLS,
+		},
 		{
 			name:      "StatefulSet",
 			reconcile: r.reconcileStatefulSet,
@@ -281,6 +290,11 @@ func (r *MaxScaleReconciler) setMariadbDefaults(ctx context.Context, req *reques
 		if mxs.Spec.Monitor.Params == nil {
 			mxs.Spec.Monitor.Params = monitorParams
 		}
+		if mxs.IsTLSEnabled() && mxs.Spec.TLS.ServerCASecretKeyRef == nil &&
+			mdb.IsTLSEnabled() && mdb.Namespace == mxs.Namespace {
+			serverCASecretKeyRef := mdb.TLSServerCASecretKeyRef()
+			mxs.Spec.TLS.ServerCASecretKeyRef = &serverCASecretKeyRef
+		}
 		mxs.SetDefaults(r.Environment)
 	})
 }
@@ -361,7 +375,11 @@ func (r *MaxScaleReconciler) reconcileServiceAccount(ctx context.Context, req *r
 
 func (r *MaxScaleReconciler) reconcileStatefulSet(ctx context.Context, req *requestMaxScale) (ctrl.Result, error) {
 	key := client.ObjectKeyFromObject(req.mxs)
-	desiredSts, err := r.Builder.BuildMaxscaleStatefulSet(req.mxs, key)
+	podAnnotations, err := r.tlsPodAnnotations(ctx, req.mxs)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	desiredSts, err := r.Builder.BuildMaxscaleStatefulSet(req.mxs, key, podAnnotations)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error building StatefulSet: %v", err)
 	}
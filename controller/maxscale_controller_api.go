@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -13,6 +14,7 @@ import (
 	"github.com/mariadb-operator/mariadb-operator/pkg/health"
 	mdbhttp "github.com/mariadb-operator/mariadb-operator/pkg/http"
 	mxsclient "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/client"
+	maxscaleresources "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/resources"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -52,7 +54,7 @@ func (m *maxScaleAPI) createAdminUser(ctx context.Context) error {
 // MaxScale API - Servers
 
 func (m *maxScaleAPI) createServer(ctx context.Context, srv *mariadbv1alpha1.MaxScaleServer) error {
-	return m.client.Server.Create(ctx, srv.Name, serverAttributes(srv))
+	return m.client.Server.Create(ctx, srv.Name, m.serverAttributes(srv))
 }
 
 func (m *maxScaleAPI) deleteServer(ctx context.Context, name string) error {
@@ -60,7 +62,7 @@ func (m *maxScaleAPI) deleteServer(ctx context.Context, name string) error {
 }
 
 func (m *maxScaleAPI) patchServer(ctx context.Context, srv *mariadbv1alpha1.MaxScaleServer) error {
-	return m.client.Server.Patch(ctx, srv.Name, serverAttributes(srv))
+	return m.client.Server.Patch(ctx, srv.Name, m.serverAttributes(srv))
 }
 
 func (m *maxScaleAPI) updateServerState(ctx context.Context, srv *mariadbv1alpha1.MaxScaleServer) error {
@@ -70,13 +72,23 @@ func (m *maxScaleAPI) updateServerState(ctx context.Context, srv *mariadbv1alpha
 	return m.client.Server.ClearMaintenance(ctx, srv.Name)
 }
 
-func serverAttributes(srv *mariadbv1alpha1.MaxScaleServer) mxsclient.ServerAttributes {
+func (m *maxScaleAPI) serverAttributes(srv *mariadbv1alpha1.MaxScaleServer) mxsclient.ServerAttributes {
+	params := srv.Params
+	if m.mxs.IsServerTLSEnabled() {
+		verify := strconv.FormatBool(ptr.Deref(m.mxs.Spec.TLS.VerifyServerCertificate, true))
+		params = withDefaultParams(srv.Params, map[string]string{
+			"ssl":                         "true",
+			"ssl_ca":                      maxscaleresources.TLSServerCAPath,
+			"ssl_verify_peer_certificate": verify,
+			"ssl_verify_peer_host":        verify,
+		})
+	}
 	return mxsclient.ServerAttributes{
 		Parameters: mxsclient.ServerParameters{
 			Address:  srv.Address,
 			Port:     srv.Port,
 			Protocol: srv.Protocol,
-			Params:   mxsclient.NewMapParams(srv.Params),
+			Params:   mxsclient.NewMapParams(params),
 		},
 	}
 }
@@ -197,7 +209,7 @@ func (m *maxScaleAPI) serviceRelationships(service string) *mxsclient.Relationsh
 // MaxScale API - Listeners
 
 func (m *maxScaleAPI) createListener(ctx context.Context, listener *mariadbv1alpha1.MaxScaleListener, rels *mxsclient.Relationships) error {
-	return m.client.Listener.Create(ctx, listener.Name, listenerAttributes(listener), mxsclient.WithRelationships(rels))
+	return m.client.Listener.Create(ctx, listener.Name, m.listenerAttributes(listener), mxsclient.WithRelationships(rels))
 }
 
 func (m *maxScaleAPI) deleteListener(ctx context.Context, name string) error {
@@ -205,7 +217,7 @@ func (m *maxScaleAPI) deleteListener(ctx context.Context, name string) error {
 }
 
 func (m *maxScaleAPI) patchListener(ctx context.Context, listener *mariadbv1alpha1.MaxScaleListener, rels *mxsclient.Relationships) error {
-	return m.client.Listener.Patch(ctx, listener.Name, listenerAttributes(listener), mxsclient.WithRelationships(rels))
+	return m.client.Listener.Patch(ctx, listener.Name, m.listenerAttributes(listener), mxsclient.WithRelationships(rels))
 }
 
 func (m *maxScaleAPI) updateListenerState(ctx context.Context, listener *mariadbv1alpha1.MaxScaleListener) error {
@@ -215,16 +227,37 @@ func (m *maxScaleAPI) updateListenerState(ctx context.Context, listener *mariadb
 	return m.client.Listener.Start(ctx, listener.Name)
 }
 
-func listenerAttributes(listener *mariadbv1alpha1.MaxScaleListener) mxsclient.ListenerAttributes {
+func (m *maxScaleAPI) listenerAttributes(listener *mariadbv1alpha1.MaxScaleListener) mxsclient.ListenerAttributes {
+	params := listener.Params
+	if m.mxs.IsTLSEnabled() {
+		params = withDefaultParams(listener.Params, map[string]string{
+			"ssl":      "true",
+			"ssl_cert": maxscaleresources.TLSCertPath,
+			"ssl_key":  maxscaleresources.TLSKeyPath,
+			"ssl_ca":   maxscaleresources.TLSCAPath,
+		})
+	}
 	return mxsclient.ListenerAttributes{
 		Parameters: mxsclient.ListenerParameters{
 			Port:     listener.Port,
 			Protocol: listener.Protocol,
-			Params:   mxsclient.NewMapParams(listener.Params),
+			Params:   mxsclient.NewMapParams(params),
 		},
 	}
 }
 
+// withDefaultParams merges the user provided params on top of the defaults, so the former take precedence.
+func withDefaultParams(params, defaults map[string]string) map[string]string {
+	merged := make(map[string]string, len(params)+len(defaults))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range params {
+		merged[k] = v
+	}
+	return merged
+}
+
 // MaxScale API - MaxScale
 
 func (m *maxScaleAPI) isMaxScaleConfigSynced(ctx context.Context) (bool, error) {
@@ -275,6 +308,13 @@ func (r *MaxScaleReconciler) defaultClientWithPodIndex(ctx context.Context, mxs
 		logger := apiLogger(ctx)
 		opts = append(opts, mdbhttp.WithLogger(&logger))
 	}
+	if mxs.IsTLSEnabled() {
+		tlsOpt, err := r.tlsTransportOption(ctx, mxs)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tlsOpt)
+	}
 	return mxsclient.NewClientWithDefaultCredentials(mxs.PodAPIUrl(podIndex), opts...)
 }
 
@@ -310,9 +350,28 @@ func (r *MaxScaleReconciler) clientWithAPIUrl(ctx context.Context, mxs *mariadbv
 		logger := apiLogger(ctx)
 		opts = append(opts, mdbhttp.WithLogger(&logger))
 	}
+	if mxs.IsTLSEnabled() {
+		tlsOpt, err := r.tlsTransportOption(ctx, mxs)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tlsOpt)
+	}
 	return mxsclient.NewClient(apiUrl, opts...)
 }
 
+func (r *MaxScaleReconciler) tlsTransportOption(ctx context.Context, mxs *mariadbv1alpha1.MaxScale) (mdbhttp.Option, error) {
+	caCert, err := r.RefResolver.SecretKeyRef(ctx, mxs.TLSCASecretKeyRef(), mxs.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting TLS CA: %v", err)
+	}
+	transport, err := mdbhttp.NewTLSTransport([]byte(caCert))
+	if err != nil {
+		return nil, fmt.Errorf("error creating TLS transport: %v", err)
+	}
+	return mdbhttp.WithTransport(transport), nil
+}
+
 func apiLogger(ctx context.Context) logr.Logger {
 	return log.FromContext(ctx).WithName("api")
 }
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
+	certctrl "github.com/mariadb-operator/mariadb-operator/pkg/controller/certificate"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (r *MaxScaleReconciler) reconcileTLS(ctx context.Context, req *requestMaxScale) (ctrl.Result, error) {
+	mxs := req.mxs
+	if !mxs.IsTLSEnabled() {
+		return ctrl.Result{}, nil
+	}
+	if mxs.IsTLSIssuedByOperator() {
+		if _, err := r.tlsCertReconciler(mxs).Reconcile(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error reconciling TLS certificates: %v", err)
+		}
+	}
+	if mxs.IsTLSIssuedByCertManager() {
+		return r.reconcileCertManagerCertificate(ctx, mxs)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *MaxScaleReconciler) reconcileCertManagerCertificate(ctx context.Context,
+	mxs *mariadbv1alpha1.MaxScale) (ctrl.Result, error) {
+	exist, err := r.DiscoveryClient.CertificateExist()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !exist {
+		r.Recorder.Event(mxs, corev1.EventTypeWarning, mariadbv1alpha1.ReasonCRDNotFound,
+			"Unable to reconcile TLS: Certificate CRD not installed in the cluster")
+		log.FromContext(ctx).Error(errors.New("Certificate CRD not installed in the cluster"), "Unable to reconcile TLS")
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	certDNSNames := maxscaleDNSNames(mxs)
+	certValidity := certctrl.DefaultCertValidity
+	if mxs.Spec.TLS.CertValidity != nil {
+		certValidity = mxs.Spec.TLS.CertValidity.Duration
+	}
+	lookaheadValidity := maxscaleTLSLookaheadValidity(mxs)
+	opts := builder.CertificateOpts{
+		Key:         mxs.TLSCertSecretKey(),
+		SecretName:  mxs.TLSCertSecretRef().Name,
+		CommonName:  certDNSNames.CommonName,
+		DNSNames:    certDNSNames.Names,
+		IssuerRef:   *mxs.Spec.TLS.IssuerRef,
+		Duration:    &certValidity,
+		RenewBefore: &lookaheadValidity,
+	}
+	desiredCert, err := r.Builder.BuildCertificate(opts, mxs)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error building Certificate: %v", err)
+	}
+	if err := r.CertificateReconciler.Reconcile(ctx, desiredCert); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error reconciling Certificate: %v", err)
+	}
+
+	var secret corev1.Secret
+	if err := r.Get(ctx, mxs.TLSCertSecretKey(), &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).V(1).Info("Certificate Secret not issued yet. Requeuing")
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("error getting Certificate Secret: %v", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// tlsPodAnnotations returns the Pod annotations containing a hash of the certificates,
+// which triggers a rolling upgrade of the StatefulSet whenever they are renewed.
+func (r *MaxScaleReconciler) tlsPodAnnotations(ctx context.Context, mxs *mariadbv1alpha1.MaxScale) (map[string]string, error) {
+	if !mxs.IsTLSEnabled() {
+		return nil, nil
+	}
+	secretKeyRefs := []corev1.SecretKeySelector{
+		mxs.TLSCASecretKeyRef(),
+		{
+			LocalObjectReference: mxs.TLSCertSecretRef(),
+			Key:                  corev1.TLSCertKey,
+		},
+	}
+	if mxs.IsServerTLSEnabled() {
+		secretKeyRefs = append(secretKeyRefs, *mxs.Spec.TLS.ServerCASecretKeyRef)
+	}
+
+	hash := sha256.New()
+	for _, secretKeyRef := range secretKeyRefs {
+		data, err := r.RefResolver.SecretKeyRef(ctx, secretKeyRef, mxs.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error getting TLS certificate: %v", err)
+		}
+		hash.Write([]byte(data))
+	}
+	return map[string]string{
+		metadata.TLSCertHashAnnotation: fmt.Sprintf("%x", hash.Sum(nil)),
+	}, nil
+}
+
+func (r *MaxScaleReconciler) tlsCertReconciler(mxs *mariadbv1alpha1.MaxScale) *certctrl.CertReconciler {
+	certDNSNames := maxscaleDNSNames(mxs)
+	opts := []certctrl.CertReconcilerOpt{
+		certctrl.WithOwnerReferences(
+			*metav1.NewControllerRef(mxs, mariadbv1alpha1.GroupVersion.WithKind("MaxScale")),
+		),
+		certctrl.WithLookaheadValidity(maxscaleTLSLookaheadValidity(mxs)),
+	}
+	if mxs.Spec.TLS.CAValidity != nil {
+		opts = append(opts, certctrl.WithCAValidity(mxs.Spec.TLS.CAValidity.Duration))
+	}
+	if mxs.Spec.TLS.CertValidity != nil {
+		opts = append(opts, certctrl.WithCertValidity(mxs.Spec.TLS.CertValidity.Duration))
+	}
+
+	return certctrl.NewCertReconciler(
+		r.Client,
+		mxs.TLSCASecretKey(),
+		fmt.Sprintf("%s-ca", mxs.Name),
+		mxs.TLSCertSecretKey(),
+		certDNSNames.CommonName,
+		certDNSNames.Names,
+		opts...,
+	)
+}
+
+func maxscaleTLSLookaheadValidity(mxs *mariadbv1alpha1.MaxScale) time.Duration {
+	if mxs.Spec.TLS != nil && mxs.Spec.TLS.LookaheadValidity != nil {
+		return mxs.Spec.TLS.LookaheadValidity.Duration
+	}
+	return certctrl.DefaultLookaheadValidity
+}
+
+func maxscaleDNSNames(mxs *mariadbv1alpha1.MaxScale) *dnsNames {
+	names := serviceDNSNames(client.ObjectKeyFromObject(mxs))
+	internalSvcKey := mxs.InternalServiceKey()
+	names.Names = append(names.Names, serviceDNSNames(internalSvcKey).Names...)
+	names.Names = append(names.Names,
+		fmt.Sprintf("*.%s", statefulset.ServiceFQDNWithService(mxs.ObjectMeta, internalSvcKey.Name)),
+		fmt.Sprintf("*.%s.%s.svc", internalSvcKey.Name, internalSvcKey.Namespace),
+		fmt.Sprintf("*.%s.%s", internalSvcKey.Name, internalSvcKey.Namespace),
+		fmt.Sprintf("*.%s", internalSvcKey.Name),
+		"localhost",
+	)
+	return names
+}
@@ -11,6 +11,7 @@ import (
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/batch"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -71,11 +72,22 @@ func (r *RestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, fmt.Errorf("error initializing source: %v", sourceErr)
 	}
 
+	if restore.Spec.RestoreSource.IsPhysical() {
+		if err := r.validatePhysicalRestore(ctx, &restore, mariaDb); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error validating physical restore: %v", err)
+		}
+	}
+
 	var jobErr *multierror.Error
 	err = r.BatchReconciler.Reconcile(ctx, &restore, mariaDb)
 	jobErr = multierror.Append(jobErr, err)
 
-	patcher, err := r.ConditionComplete.PatcherWithJob(ctx, err, req.NamespacedName)
+	failedMessage := "Failed"
+	if restore.Spec.RestoreSource.IsStreaming() {
+		// Streaming restores apply the backup before its digest is verified.
+		failedMessage = "Streaming restore failed, the database might have been partially restored"
+	}
+	patcher, err := r.ConditionComplete.PatcherWithJobAndFailedMessage(ctx, err, req.NamespacedName, failedMessage)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -142,6 +154,31 @@ func (r *RestoreReconciler) setDefaults(ctx context.Context, restore *mariadbv1a
 	return nil
 }
 
+// validatePhysicalRestore ensures that the datadir is not in use by a running MariaDB,
+// as physical backups can only be copied back when bootstrapping a new MariaDB.
+func (r *RestoreReconciler) validatePhysicalRestore(ctx context.Context, restore *mariadbv1alpha1.Restore,
+	mariadb *mariadbv1alpha1.MariaDB) error {
+	var existingJob batchv1.Job
+	if err := r.Get(ctx, client.ObjectKeyFromObject(restore), &existingJob); err == nil {
+		return nil
+	}
+	var existingSts appsv1.StatefulSet
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mariadb), &existingSts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting StatefulSet: %v", err)
+	}
+
+	var errBundle *multierror.Error
+	errBundle = multierror.Append(errBundle, errors.New("physical restores are only supported when bootstrapping a MariaDB"))
+
+	err := r.patchStatus(ctx, restore, r.ConditionComplete.PatcherFailed("Physical restores are only supported when bootstrapping a MariaDB"))
+	errBundle = multierror.Append(errBundle, err)
+
+	return errBundle
+}
+
 func (r *RestoreReconciler) patchStatus(ctx context.Context, restore *mariadbv1alpha1.Restore,
 	patcher condition.Patcher) error {
 	patch := client.MergeFrom(restore.DeepCopy())
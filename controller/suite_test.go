@@ -11,6 +11,7 @@ import (
 	condition "github.com/mariadb-operator/mariadb-operator/pkg/condition"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/auth"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/batch"
+	"github.com/mariadb-operator/mariadb-operator/pkg/controller/certmanager"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/configmap"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/deployment"
 	"github.com/mariadb-operator/mariadb-operator/pkg/controller/endpoints"
@@ -136,6 +137,7 @@ var _ = BeforeSuite(func() {
 	rbacReconciler := rbac.NewRBACReconiler(client, builder)
 	deployReconciler := deployment.NewDeploymentReconciler(client)
 	svcMonitorReconciler := servicemonitor.NewServiceMonitorReconciler(client)
+	certificateReconciler := certmanager.NewCertificateReconciler(client)
 
 	mxsReconciler := maxscale.NewMaxScaleReconciler(client, builder, env)
 	replConfig := replication.NewReplicationConfig(client, builder, secretReconciler)
@@ -204,6 +206,7 @@ var _ = BeforeSuite(func() {
 		AuthReconciler:           authReconciler,
 		DeploymentReconciler:     deployReconciler,
 		ServiceMonitorReconciler: svcMonitorReconciler,
+		CertificateReconciler:    certificateReconciler,
 
 		MaxScaleReconciler:    mxsReconciler,
 		ReplicationReconciler: replicationReconciler,
@@ -216,16 +219,18 @@ var _ = BeforeSuite(func() {
 		Scheme:   scheme,
 		Recorder: k8sManager.GetEventRecorderFor("maxscale"),
 
-		Builder:        builder,
-		ConditionReady: conditionReady,
-		Environment:    env,
-		RefResolver:    refResolver,
+		Builder:         builder,
+		ConditionReady:  conditionReady,
+		Environment:     env,
+		RefResolver:     refResolver,
+		DiscoveryClient: discoveryClient,
 
 		SecretReconciler:      secretReconciler,
 		RBACReconciler:        rbacReconciler,
 		AuthReconciler:        authReconciler,
 		StatefulSetReconciler: statefulSetReconciler,
 		ServiceReconciler:     serviceReconciler,
+		CertificateReconciler: certificateReconciler,
 
 		SuspendEnabled: false,
 
@@ -241,6 +246,8 @@ var _ = BeforeSuite(func() {
 		RefResolver:       refResolver,
 		ConditionComplete: conditionComplete,
 		BatchReconciler:   batchReconciler,
+		DiscoveryClient:   discoveryClient,
+		Recorder:          k8sManager.GetEventRecorderFor("backup"),
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -0,0 +1,12 @@
+#!/bin/bash
+
+set -eo pipefail
+
+CONFIG="$( dirname "${BASH_SOURCE[0]}" )"/config
+
+kubectl create namespace azurite --dry-run=client -o yaml | kubectl apply -f -
+kubectl apply -f $CONFIG/azurite.yaml
+kubectl wait --for=condition=available deployment/azurite -n azurite --timeout=120s
+kubectl run azurite-container -n azurite --rm -i --restart=Never --image=mcr.microsoft.com/azure-cli -- \
+  az storage container create --name backups \
+  --connection-string "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;BlobEndpoint=http://azurite.azurite.svc.cluster.local:10000/devstoreaccount1;"
@@ -0,0 +1,25 @@
+#!/bin/bash
+
+set -eo pipefail
+
+CONFIG="$( dirname "${BASH_SOURCE[0]}" )"/config
+if [ -z "$EXTERNAL_SNAPSHOTTER_VERSION" ]; then
+  EXTERNAL_SNAPSHOTTER_VERSION="v6.3.3"
+fi
+if [ -z "$CSI_HOSTPATH_VERSION" ]; then
+  CSI_HOSTPATH_VERSION="v1.12.1"
+fi
+SNAPSHOTTER_URL="https://raw.githubusercontent.com/kubernetes-csi/external-snapshotter/$EXTERNAL_SNAPSHOTTER_VERSION"
+
+for crd in volumesnapshotclasses volumesnapshotcontents volumesnapshots; do
+  kubectl apply -f $SNAPSHOTTER_URL/client/config/crd/snapshot.storage.k8s.io_$crd.yaml
+done
+kubectl apply -f $SNAPSHOTTER_URL/deploy/kubernetes/snapshot-controller/rbac-snapshot-controller.yaml
+kubectl apply -f $SNAPSHOTTER_URL/deploy/kubernetes/snapshot-controller/setup-snapshot-controller.yaml
+
+CSI_HOSTPATH_DIR=$(mktemp -d)
+trap "rm -rf $CSI_HOSTPATH_DIR" EXIT
+git clone --depth 1 --branch $CSI_HOSTPATH_VERSION https://github.com/kubernetes-csi/csi-driver-host-path.git $CSI_HOSTPATH_DIR
+$CSI_HOSTPATH_DIR/deploy/kubernetes-latest/deploy.sh
+
+kubectl apply -f $CONFIG/csi-hostpath.yaml
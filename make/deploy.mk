@@ -120,6 +120,16 @@ MINIO_VERSION ?= "5.0.14"
 install-minio: cluster-ctx cert-minio ## Install minio helm chart.
 	@./hack/install_minio.sh
 
+.PHONY: install-azurite
+install-azurite: cluster-ctx ## Install Azurite, the Azure Blob Storage emulator.
+	@./hack/install_azurite.sh
+
+EXTERNAL_SNAPSHOTTER_VERSION ?= "v6.3.3"
+CSI_HOSTPATH_VERSION ?= "v1.12.1"
+.PHONY: install-csi-hostpath
+install-csi-hostpath: cluster-ctx ## Install the CSI hostpath driver with VolumeSnapshot support.
+	@./hack/install_csi_hostpath.sh
+
 .PHONY: install-crds
 install-crds: cluster-ctx manifests kustomize ## Install CRDs.
 	$(KUSTOMIZE) build config/crd | kubectl apply --server-side=true --force-conflicts -f -
@@ -12,6 +12,9 @@ import (
 
 const timeLayout = time.RFC3339
 
+// MydumperExtension is the extension of the tarballs archiving the directories dumped by mydumper.
+const MydumperExtension = "mydumper.tar"
+
 // time.Now cannot be mocked globablly, this is to allow overriding the now func from tests
 var now = time.Now
 
@@ -48,6 +51,84 @@ func GetBackupTargetFile(backupFileNames []string, targetRecoveryTime time.Time,
 	return backupDiffs[0].fileName, nil
 }
 
+// GetBackupTargetFiles finds the backup files to be restored with the closest date to the target recovery time.
+// When a database is provided, the closest backup file containing that database is returned, which might be a backup file
+// of that database or a backup file containing all the databases, the former taking precedence when they are equally close.
+// Otherwise, the closest backup file containing all the databases is returned or, if there are none, the closest set of
+// per-database backup files.
+func GetBackupTargetFiles(backupFileNames []string, targetRecoveryTime time.Time, database string,
+	logger logr.Logger) ([]string, error) {
+	var databaseFiles, allDatabasesFiles, perDatabaseFiles []string
+	for _, file := range backupFileNames {
+		fileDatabase := ParseDatabaseInBackupFile(file)
+		if fileDatabase == "" {
+			allDatabasesFiles = append(allDatabasesFiles, file)
+			continue
+		}
+		perDatabaseFiles = append(perDatabaseFiles, file)
+		if fileDatabase == database {
+			databaseFiles = append(databaseFiles, file)
+		}
+	}
+
+	if database != "" {
+		file, err := getDatabaseTargetFile(databaseFiles, allDatabasesFiles, targetRecoveryTime, logger)
+		if err != nil {
+			return nil, fmt.Errorf("error getting backup file for database '%s': %v", database, err)
+		}
+		return []string{file}, nil
+	}
+	if len(allDatabasesFiles) > 0 {
+		file, err := GetBackupTargetFile(allDatabasesFiles, targetRecoveryTime, logger)
+		if err != nil {
+			return nil, err
+		}
+		return []string{file}, nil
+	}
+
+	file, err := GetBackupTargetFile(perDatabaseFiles, targetRecoveryTime, logger)
+	if err != nil {
+		return nil, err
+	}
+	targetDate, err := parseDateInBackupFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, file := range perDatabaseFiles {
+		date, err := parseDateInBackupFile(file)
+		if err == nil && date.Equal(targetDate) {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+func getDatabaseTargetFile(databaseFiles, allDatabasesFiles []string, targetRecoveryTime time.Time,
+	logger logr.Logger) (string, error) {
+	databaseFile, databaseErr := GetBackupTargetFile(databaseFiles, targetRecoveryTime, logger)
+	allDatabasesFile, allDatabasesErr := GetBackupTargetFile(allDatabasesFiles, targetRecoveryTime, logger)
+	if databaseErr != nil {
+		return allDatabasesFile, allDatabasesErr
+	}
+	if allDatabasesErr != nil {
+		return databaseFile, nil
+	}
+
+	databaseDate, err := parseDateInBackupFile(databaseFile)
+	if err != nil {
+		return "", err
+	}
+	allDatabasesDate, err := parseDateInBackupFile(allDatabasesFile)
+	if err != nil {
+		return "", err
+	}
+	if allDatabasesDate.Sub(targetRecoveryTime).Abs() < databaseDate.Sub(targetRecoveryTime).Abs() {
+		return allDatabasesFile, nil
+	}
+	return databaseFile, nil
+}
+
 // GetOldBackupFiles determines which backup files should be deleted according with the retention policy.
 func GetOldBackupFiles(backupFileNames []string, maxRetention time.Duration, logger logr.Logger) []string {
 	var oldBackups []string
@@ -66,14 +147,39 @@ func GetOldBackupFiles(backupFileNames []string, maxRetention time.Duration, log
 }
 
 // IsValidBackupFile determines whether a backup file name is valid.
+// Logical backup files may be compressed, in which case the compression extension is expected after the .sql one.
+// Encrypted backup files are expected to have the encryption extension at the end.
 func IsValidBackupFile(fileName string) bool {
-	if !strings.HasPrefix(fileName, "backup.") || !strings.HasSuffix(fileName, ".sql") {
+	if !strings.HasPrefix(fileName, "backup.") ||
+		(!strings.HasSuffix(trimCompressExtension(DecryptedBackupFile(fileName)), ".sql") &&
+			!IsPhysicalBackupFile(fileName) && !IsMydumperBackupFile(fileName)) {
 		return false
 	}
 	_, err := parseDateInBackupFile(fileName)
 	return err == nil
 }
 
+// IsPhysicalBackupFile determines whether a backup file is an archive of a prepared mariabackup datadir.
+func IsPhysicalBackupFile(fileName string) bool {
+	return strings.HasSuffix(DecryptedBackupFile(fileName), ".tar") && !IsMydumperBackupFile(fileName)
+}
+
+// IsMydumperBackupFile determines whether a backup file is an archive of a directory dumped by mydumper.
+func IsMydumperBackupFile(fileName string) bool {
+	return strings.HasSuffix(DecryptedBackupFile(fileName), "."+MydumperExtension)
+}
+
+// FilterBackupFiles returns the backup files taken with the physical or logical method.
+func FilterBackupFiles(backupFileNames []string, physical bool) []string {
+	var files []string
+	for _, file := range backupFileNames {
+		if IsPhysicalBackupFile(file) == physical {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
 // FormatBackupDate formats a time with the layout compatible with this module.
 func FormatBackupDate(t time.Time) string {
 	return t.Format(timeLayout)
@@ -88,10 +194,24 @@ func ParseBackupDate(timeRaw string) (time.Time, error) {
 	return t, nil
 }
 
+// ParseDatabaseInBackupFile returns the database of a per-database backup file, named backup.<date>.<database>.sql.
+// An empty database is returned for backup files containing all the databases.
+func ParseDatabaseInBackupFile(fileName string) string {
+	parts := backupFileParts(fileName)
+	if len(parts) != 4 || IsMydumperBackupFile(fileName) {
+		return ""
+	}
+	return parts[2]
+}
+
 func parseDateInBackupFile(fileName string) (time.Time, error) {
-	parts := strings.Split(fileName, ".")
-	if len(parts) != 3 {
+	parts := backupFileParts(fileName)
+	if len(parts) != 3 && len(parts) != 4 {
 		return time.Time{}, fmt.Errorf("invalid backup file name: %s", fileName)
 	}
 	return ParseBackupDate(parts[1])
 }
+
+func backupFileParts(fileName string) []string {
+	return strings.Split(trimCompressExtension(DecryptedBackupFile(fileName)), ".")
+}
@@ -46,6 +46,86 @@ func TestIsValidBackupFile(t *testing.T) {
 			backupFile: "backup.2023-12-18T16:14:00Z.sql",
 			wantValid:  true,
 		},
+		{
+			name:       "invalid extension",
+			backupFile: "backup.2023-12-18T16:14:00Z.zip",
+			wantValid:  false,
+		},
+		{
+			name:       "valid physical",
+			backupFile: "backup.2023-12-18T16:14:00Z.tar",
+			wantValid:  true,
+		},
+		{
+			name:       "valid mydumper",
+			backupFile: "backup.2023-12-18T16:14:00Z.mydumper.tar",
+			wantValid:  true,
+		},
+		{
+			name:       "valid mydumper encrypted",
+			backupFile: "backup.2023-12-18T16:14:00Z.mydumper.tar.enc",
+			wantValid:  true,
+		},
+		{
+			name:       "valid gzip",
+			backupFile: "backup.2023-12-18T16:14:00Z.sql.gz",
+			wantValid:  true,
+		},
+		{
+			name:       "valid zstd",
+			backupFile: "backup.2023-12-18T16:14:00Z.sql.zst",
+			wantValid:  true,
+		},
+		{
+			name:       "valid bzip2",
+			backupFile: "backup.2023-12-18T16:14:00Z.sql.bz2",
+			wantValid:  true,
+		},
+		{
+			name:       "valid encrypted",
+			backupFile: "backup.2023-12-18T16:14:00Z.sql.enc",
+			wantValid:  true,
+		},
+		{
+			name:       "valid compressed and encrypted",
+			backupFile: "backup.2023-12-18T16:14:00Z.sql.gz.enc",
+			wantValid:  true,
+		},
+		{
+			name:       "valid physical encrypted",
+			backupFile: "backup.2023-12-18T16:14:00Z.tar.enc",
+			wantValid:  true,
+		},
+		{
+			name:       "encrypted without extension",
+			backupFile: "backup.2023-12-18T16:14:00Z.enc",
+			wantValid:  false,
+		},
+		{
+			name:       "compressed without extension",
+			backupFile: "backup.2023-12-18T16:14:00Z.gz",
+			wantValid:  false,
+		},
+		{
+			name:       "compressed physical",
+			backupFile: "backup.2023-12-18T16:14:00Z.tar.gz",
+			wantValid:  false,
+		},
+		{
+			name:       "valid database",
+			backupFile: "backup.2023-12-18T16:14:00Z.app.sql",
+			wantValid:  true,
+		},
+		{
+			name:       "valid database compressed and encrypted",
+			backupFile: "backup.2023-12-18T16:14:00Z.app.sql.gz.enc",
+			wantValid:  true,
+		},
+		{
+			name:       "database with invalid date",
+			backupFile: "backup.foo.app.sql",
+			wantValid:  false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -58,6 +138,48 @@ func TestIsValidBackupFile(t *testing.T) {
 	}
 }
 
+func TestFilterBackupFiles(t *testing.T) {
+	backupFiles := []string{
+		"backup.2023-12-18T16:14:00Z.sql",
+		"backup.2023-12-19T16:14:00Z.tar",
+		"backup.2023-12-20T16:14:00Z.sql",
+		"backup.2023-12-21T16:14:00Z.tar",
+		"backup.2023-12-22T16:14:00Z.mydumper.tar",
+	}
+	tests := []struct {
+		name      string
+		physical  bool
+		wantFiles []string
+	}{
+		{
+			name:     "logical",
+			physical: false,
+			wantFiles: []string{
+				"backup.2023-12-18T16:14:00Z.sql",
+				"backup.2023-12-20T16:14:00Z.sql",
+				"backup.2023-12-22T16:14:00Z.mydumper.tar",
+			},
+		},
+		{
+			name:     "physical",
+			physical: true,
+			wantFiles: []string{
+				"backup.2023-12-19T16:14:00Z.tar",
+				"backup.2023-12-21T16:14:00Z.tar",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := FilterBackupFiles(backupFiles, tt.physical)
+			if !reflect.DeepEqual(tt.wantFiles, files) {
+				t.Fatalf("unexpected backup files, expected: %v got: %v", tt.wantFiles, files)
+			}
+		})
+	}
+}
+
 func TestGetTargerRecoveryFile(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -183,6 +305,32 @@ func TestGetTargerRecoveryFile(t *testing.T) {
 			wantFile:       "backup.2023-12-18T16:07:00Z.sql",
 			wantErr:        false,
 		},
+		{
+			name: "compressed",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+				"backup.2023-12-18T15:59:00Z.sql.gz",
+				"backup.2023-12-18T16:00:00Z.sql.zst",
+				"backup.2023-12-18T16:03:00Z.sql.bz2",
+				"backup.2023-12-18T16:07:00Z.sql.gz",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:04:00Z"),
+			wantFile:       "backup.2023-12-18T16:03:00Z.sql.bz2",
+			wantErr:        false,
+		},
+		{
+			name: "encrypted",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+				"backup.2023-12-18T15:59:00Z.sql.enc",
+				"backup.2023-12-18T16:00:00Z.sql.gz",
+				"backup.2023-12-18T16:03:00Z.sql.gz.enc",
+				"backup.2023-12-18T16:07:00Z.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:04:00Z"),
+			wantFile:       "backup.2023-12-18T16:03:00Z.sql.gz.enc",
+			wantErr:        false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -205,6 +353,158 @@ func TestGetTargerRecoveryFile(t *testing.T) {
 	}
 }
 
+func TestParseDatabaseInBackupFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		backupFile   string
+		wantDatabase string
+	}{
+		{
+			name:         "all databases",
+			backupFile:   "backup.2023-12-18T16:14:00Z.sql",
+			wantDatabase: "",
+		},
+		{
+			name:         "database",
+			backupFile:   "backup.2023-12-18T16:14:00Z.app.sql",
+			wantDatabase: "app",
+		},
+		{
+			name:         "database compressed and encrypted",
+			backupFile:   "backup.2023-12-18T16:14:00Z.app.sql.zst.enc",
+			wantDatabase: "app",
+		},
+		{
+			name:         "mydumper",
+			backupFile:   "backup.2023-12-18T16:14:00Z.mydumper.tar",
+			wantDatabase: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := ParseDatabaseInBackupFile(tt.backupFile)
+			if tt.wantDatabase != database {
+				t.Fatalf("unexpected database, expected: %v got: %v", tt.wantDatabase, database)
+			}
+		})
+	}
+}
+
+func TestGetBackupTargetFiles(t *testing.T) {
+	tests := []struct {
+		name           string
+		backupFiles    []string
+		targetRecovery time.Time
+		database       string
+		wantFiles      []string
+		wantErr        bool
+	}{
+		{
+			name:           "no backups",
+			backupFiles:    []string{},
+			targetRecovery: time.Now(),
+			wantFiles:      nil,
+			wantErr:        true,
+		},
+		{
+			name: "all databases",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+				"backup.2023-12-18T16:58:00Z.sql",
+				"backup.2023-12-18T16:58:00Z.app.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T17:00:00Z"),
+			wantFiles: []string{
+				"backup.2023-12-18T16:58:00Z.sql",
+			},
+			wantErr: false,
+		},
+		{
+			name: "per-database backup set",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.app.sql",
+				"backup.2023-12-18T15:58:00Z.billing.sql",
+				"backup.2023-12-18T16:58:00Z.app.sql",
+				"backup.2023-12-18T16:58:00Z.billing.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:00:00Z"),
+			wantFiles: []string{
+				"backup.2023-12-18T15:58:00Z.app.sql",
+				"backup.2023-12-18T15:58:00Z.billing.sql",
+			},
+			wantErr: false,
+		},
+		{
+			name: "database",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+				"backup.2023-12-18T15:58:00Z.app.sql",
+				"backup.2023-12-18T15:58:00Z.billing.sql",
+				"backup.2023-12-18T16:58:00Z.app.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:00:00Z"),
+			database:       "billing",
+			wantFiles: []string{
+				"backup.2023-12-18T15:58:00Z.billing.sql",
+			},
+			wantErr: false,
+		},
+		{
+			name: "database in all databases backup",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+				"backup.2023-12-18T15:58:00Z.app.sql",
+				"backup.2023-12-18T16:58:00Z.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:30:00Z"),
+			database:       "app",
+			wantFiles: []string{
+				"backup.2023-12-18T16:58:00Z.sql",
+			},
+			wantErr: false,
+		},
+		{
+			name: "database only in all databases backup",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+				"backup.2023-12-18T15:58:00Z.app.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:00:00Z"),
+			database:       "billing",
+			wantFiles: []string{
+				"backup.2023-12-18T15:58:00Z.sql",
+			},
+			wantErr: false,
+		},
+		{
+			name: "database not found",
+			backupFiles: []string{
+				"backup.2023-12-18T15:58:00Z.app.sql",
+			},
+			targetRecovery: mustParseDate(t, "2023-12-18T16:00:00Z"),
+			database:       "billing",
+			wantFiles:      nil,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := GetBackupTargetFiles(tt.backupFiles, tt.targetRecovery, tt.database, logger)
+			if tt.wantErr && err == nil {
+				t.Fatal("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantFiles, files) {
+				t.Fatalf("unexpected backup target files, expected: %v got: %v", tt.wantFiles, files)
+			}
+		})
+	}
+}
+
 func TestGetBackupFilesToDelete(t *testing.T) {
 	previousNowFunc := now
 	tests := []struct {
@@ -0,0 +1,112 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// BinlogsDir is the directory, relative to the backup path, where the archived binary logs are stored.
+	BinlogsDir = "binlogs"
+	// BinlogsTargetFile is the file, within the binlogs directory, that contains the binary logs to be replayed.
+	BinlogsTargetFile = "0-binlog-target.txt"
+)
+
+// IsValidBinlogFile determines whether an archived binary log file name is valid.
+func IsValidBinlogFile(fileName string) bool {
+	_, _, _, err := parseBinlogFile(fileName)
+	return err == nil
+}
+
+// GetBinlogName returns the name of the binary log in the server from an archived binary log file name.
+func GetBinlogName(fileName string) (string, error) {
+	_, _, binlogName, err := parseBinlogFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	return binlogName, nil
+}
+
+// GetBinlogKey returns the key that identifies an archived binary log, in the '<server>.<binlog name>' format.
+// All the servers share the same binary log basename, so the name alone is not unique after a primary switchover.
+func GetBinlogKey(fileName string) (string, error) {
+	_, server, binlogName, err := parseBinlogFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	return server + "." + binlogName, nil
+}
+
+// BinlogGtidFunc obtains the GTID position at the beginning of an archived binary log.
+type BinlogGtidFunc func(fileName string) (GtidPosition, error)
+
+// GetBinlogFilesForBackup determines which archived binary logs are needed to replay the changes
+// performed after the backup GTID position. The binary logs are walked from the newest to the oldest
+// until finding the one that contains the backup GTID position, so only the needed ones are inspected.
+// They are returned in the order they should be replayed.
+func GetBinlogFilesForBackup(binlogFileNames []string, backupGtid GtidPosition, binlogGtidFn BinlogGtidFunc,
+	logger logr.Logger) ([]string, error) {
+	if len(backupGtid) == 0 {
+		return nil, errors.New("backup GTID position must be set")
+	}
+	var files []string
+	for _, file := range binlogFileNames {
+		if _, _, _, err := parseBinlogFile(file); err != nil {
+			logger.Error(err, "error parsing binlog file. Skipping", "file", file)
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	var binlogs []string
+	for i := len(files) - 1; i >= 0; i-- {
+		file := files[i]
+		binlogGtid, err := binlogGtidFn(file)
+		if err != nil {
+			return nil, fmt.Errorf("error getting GTID position of binlog %s: %v", file, err)
+		}
+		binlogs = append([]string{file}, binlogs...)
+
+		if !binlogGtid.After(backupGtid) {
+			logger.V(1).Info("found binlog containing backup GTID", "file", file, "gtid", binlogGtid.String())
+			return binlogs, nil
+		}
+	}
+	return nil, fmt.Errorf("archived binlogs do not contain the backup GTID position %s", backupGtid.String())
+}
+
+// GetOldBinlogFiles determines which archived binary logs should be deleted according with the retention policy.
+func GetOldBinlogFiles(binlogFileNames []string, maxRetention time.Duration, logger logr.Logger) []string {
+	var oldBinlogs []string
+	now := now()
+	for _, file := range binlogFileNames {
+		binlogDate, _, _, err := parseBinlogFile(file)
+		if err != nil {
+			logger.Error(err, "error parsing binlog file. Skipping", "file", file)
+			continue
+		}
+		if now.Sub(binlogDate) > maxRetention {
+			oldBinlogs = append(oldBinlogs, file)
+		}
+	}
+	return oldBinlogs
+}
+
+// parseBinlogFile parses archived binary logs named 'binlog.<archive date>.<server>.<binlog name>'.
+func parseBinlogFile(fileName string) (time.Time, string, string, error) {
+	parts := strings.SplitN(fileName, ".", 4)
+	if len(parts) != 4 || parts[0] != "binlog" || parts[2] == "" || parts[3] == "" {
+		return time.Time{}, "", "", fmt.Errorf("invalid binlog file name: %s", fileName)
+	}
+	date, err := ParseBackupDate(parts[1])
+	if err != nil {
+		return time.Time{}, "", "", err
+	}
+	return date, parts[2], parts[3], nil
+}
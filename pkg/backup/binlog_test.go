@@ -0,0 +1,275 @@
+package backup
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIsValidBinlogFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		binlogFile string
+		wantValid  bool
+	}{
+		{
+			name:       "empty",
+			binlogFile: "",
+			wantValid:  false,
+		},
+		{
+			name:       "no prefix",
+			binlogFile: "2023-12-18T16:14:00Z.mariadb-0.mariadb-bin.000001",
+			wantValid:  false,
+		},
+		{
+			name:       "no binlog name",
+			binlogFile: "binlog.2023-12-18T16:14:00Z",
+			wantValid:  false,
+		},
+		{
+			name:       "no server",
+			binlogFile: "binlog.2023-12-18T16:14:00Z.000001",
+			wantValid:  false,
+		},
+		{
+			name:       "invalid date",
+			binlogFile: "binlog.2023-12-18 16:14.mariadb-bin.000001",
+			wantValid:  false,
+		},
+		{
+			name:       "backup file",
+			binlogFile: "backup.2023-12-18T16:14:00Z.sql",
+			wantValid:  false,
+		},
+		{
+			name:       "valid",
+			binlogFile: "binlog.2023-12-18T16:14:00Z.mariadb-0.mariadb-bin.000001",
+			wantValid:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid := IsValidBinlogFile(tt.binlogFile)
+			if tt.wantValid != valid {
+				t.Fatalf("unexpected binlog file validity, expected: %v got: %v", tt.wantValid, valid)
+			}
+		})
+	}
+}
+
+func TestGetBinlogName(t *testing.T) {
+	binlogName, err := GetBinlogName("binlog.2023-12-18T16:14:00Z.mariadb-0.mariadb-bin.000001")
+	if err != nil {
+		t.Fatalf("unexpected error getting binlog name: %v", err)
+	}
+	if binlogName != "mariadb-bin.000001" {
+		t.Fatalf("unexpected binlog name, expected: %s got: %s", "mariadb-bin.000001", binlogName)
+	}
+}
+
+func TestGetBinlogKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		binlogFile string
+		wantKey    string
+		wantErr    bool
+	}{
+		{
+			name:       "invalid",
+			binlogFile: "binlog.2023-12-18T16:14:00Z",
+			wantErr:    true,
+		},
+		{
+			name:       "first server",
+			binlogFile: "binlog.2023-12-18T16:14:00Z.mariadb-0.mariadb-bin.000001",
+			wantKey:    "mariadb-0.mariadb-bin.000001",
+		},
+		{
+			name:       "second server with the same binlog name",
+			binlogFile: "binlog.2023-12-18T16:20:00Z.mariadb-1.mariadb-bin.000001",
+			wantKey:    "mariadb-1.mariadb-bin.000001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := GetBinlogKey(tt.binlogFile)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expecting error to be non nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if key != tt.wantKey {
+				t.Fatalf("unexpected binlog key, expected: %s got: %s", tt.wantKey, key)
+			}
+		})
+	}
+}
+
+func TestGetBinlogFilesForBackup(t *testing.T) {
+	binlogGtids := map[string]string{
+		"binlog.2023-12-18T16:10:00Z.mariadb-0.mariadb-bin.000001": "",
+		"binlog.2023-12-18T16:15:00Z.mariadb-0.mariadb-bin.000002": "0-1-10",
+		"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000003": "0-1-20",
+		"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004": "0-1-30",
+		"binlog.2023-12-18T16:25:00Z.mariadb-1.mariadb-bin.000003": "0-1-40",
+		"binlog.2023-12-18T16:30:00Z.mariadb-1.mariadb-bin.000004": "0-2-50",
+	}
+	binlogGtidFn := func(fileName string) (GtidPosition, error) {
+		rawGtid, ok := binlogGtids[fileName]
+		if !ok {
+			return nil, fmt.Errorf("binlog not found: %s", fileName)
+		}
+		if rawGtid == "" {
+			return GtidPosition{}, nil
+		}
+		return ParseGtidPosition(rawGtid)
+	}
+	binlogFiles := []string{
+		"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000003",
+		"binlog.2023-12-18T16:10:00Z.mariadb-0.mariadb-bin.000001",
+		"foo",
+		"binlog.2023-12-18T16:15:00Z.mariadb-0.mariadb-bin.000002",
+		"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+	}
+
+	tests := []struct {
+		name        string
+		binlogFiles []string
+		backupGtid  string
+		wantBinlogs []string
+		wantErr     bool
+	}{
+		{
+			name:        "no binlogs",
+			binlogFiles: nil,
+			backupGtid:  "0-1-15",
+			wantBinlogs: nil,
+			wantErr:     true,
+		},
+		{
+			name:        "backup in first binlog",
+			binlogFiles: binlogFiles,
+			backupGtid:  "0-1-5",
+			wantBinlogs: []string{
+				"binlog.2023-12-18T16:10:00Z.mariadb-0.mariadb-bin.000001",
+				"binlog.2023-12-18T16:15:00Z.mariadb-0.mariadb-bin.000002",
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000003",
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+			},
+			wantErr: false,
+		},
+		{
+			name:        "backup in middle binlog",
+			binlogFiles: binlogFiles,
+			backupGtid:  "0-1-15",
+			wantBinlogs: []string{
+				"binlog.2023-12-18T16:15:00Z.mariadb-0.mariadb-bin.000002",
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000003",
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+			},
+			wantErr: false,
+		},
+		{
+			name:        "backup at binlog boundary",
+			binlogFiles: binlogFiles,
+			backupGtid:  "0-1-20",
+			wantBinlogs: []string{
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000003",
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+			},
+			wantErr: false,
+		},
+		{
+			name:        "backup in last binlog",
+			binlogFiles: binlogFiles,
+			backupGtid:  "0-1-35",
+			wantBinlogs: []string{
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+			},
+			wantErr: false,
+		},
+		{
+			name: "primary switchover with the same binlog names",
+			binlogFiles: append([]string{
+				"binlog.2023-12-18T16:30:00Z.mariadb-1.mariadb-bin.000004",
+				"binlog.2023-12-18T16:25:00Z.mariadb-1.mariadb-bin.000003",
+			}, binlogFiles...),
+			backupGtid: "0-1-35",
+			wantBinlogs: []string{
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+				"binlog.2023-12-18T16:25:00Z.mariadb-1.mariadb-bin.000003",
+				"binlog.2023-12-18T16:30:00Z.mariadb-1.mariadb-bin.000004",
+			},
+			wantErr: false,
+		},
+		{
+			name: "backup before archived binlogs",
+			binlogFiles: []string{
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000003",
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000004",
+			},
+			backupGtid:  "0-1-15",
+			wantBinlogs: nil,
+			wantErr:     true,
+		},
+		{
+			name: "error getting GTID",
+			binlogFiles: []string{
+				"binlog.2023-12-18T16:20:00Z.mariadb-0.mariadb-bin.000005",
+			},
+			backupGtid:  "0-1-15",
+			wantBinlogs: nil,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backupGtid, err := ParseGtidPosition(tt.backupGtid)
+			if err != nil {
+				t.Fatalf("unexpected error parsing backup GTID: %v", err)
+			}
+			binlogs, err := GetBinlogFilesForBackup(tt.binlogFiles, backupGtid, binlogGtidFn, logger)
+			if tt.wantErr && err == nil {
+				t.Fatal("expecting error to be non nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantBinlogs, binlogs) {
+				t.Fatalf("unexpected binlogs, expected: %v got: %v", tt.wantBinlogs, binlogs)
+			}
+		})
+	}
+}
+
+func TestGetOldBinlogFiles(t *testing.T) {
+	previousNowFunc := now
+	now = func() time.Time {
+		return time.Date(2023, 12, 20, 0, 0, 0, 0, time.UTC)
+	}
+	defer func() {
+		now = previousNowFunc
+	}()
+
+	binlogFiles := []string{
+		"binlog.2023-12-18T16:14:00Z.mariadb-0.mariadb-bin.000001",
+		"binlog.2023-12-19T16:14:00Z.mariadb-0.mariadb-bin.000002",
+		"foo",
+	}
+	wantBinlogs := []string{
+		"binlog.2023-12-18T16:14:00Z.mariadb-0.mariadb-bin.000001",
+	}
+	binlogs := GetOldBinlogFiles(binlogFiles, 24*time.Hour, logger)
+	if !reflect.DeepEqual(wantBinlogs, binlogs) {
+		t.Fatalf("unexpected old binlogs, expected: %v got: %v", wantBinlogs, binlogs)
+	}
+}
@@ -0,0 +1,192 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// GtidFile is the file where the GTID position of the target backup is written by the backup container.
+const GtidFile = "0-backup-gtid.txt"
+
+// MaxCatalogSize is the maximum size in bytes of the Catalog, bounded by the size of the termination message.
+const MaxCatalogSize = 4096
+
+// Catalog lists the latest backup files available in the storage. It is reported by the backup Job
+// in its termination message, which is limited to 4096 bytes.
+type Catalog struct {
+	// Targets are the backup files taken by the Job. There is a backup file per database when taking per-database backups.
+	Targets []CatalogEntry `json:"targets"`
+	// Backups are the latest backup files available in the storage, sorted from newest to oldest.
+	Backups []CatalogEntry `json:"backups,omitempty"`
+	// Summary describes the outcome of the Job, so it can be exposed as metrics.
+	Summary *CatalogSummary `json:"summary,omitempty"`
+}
+
+// CatalogSummary describes the outcome of a backup Job.
+type CatalogSummary struct {
+	// DurationSeconds is the time elapsed since the target backups started to be taken until the Job completed.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Size is the total size of the target backups in bytes.
+	Size int64 `json:"size"`
+	// FileCount is the number of backup files available in the storage, regardless of the catalog limit.
+	FileCount int `json:"fileCount"`
+	// CompletionTime is the time when the Job completed.
+	CompletionTime time.Time `json:"completionTime"`
+}
+
+// CatalogEntry is a backup file available in the storage.
+type CatalogEntry struct {
+	FileName string    `json:"fileName"`
+	Time     time.Time `json:"time"`
+	Database string    `json:"database,omitempty"`
+	Size     int64     `json:"size,omitempty"`
+	GTID     string    `json:"gtid,omitempty"`
+}
+
+// NewCatalog builds a Catalog with the latest backup files, limited by the given number of entries.
+// The target backups, which are expected to be available locally, are described by their size and GTID position.
+func NewCatalog(targets []CatalogEntry, backupFileNames []string, limit int, logger logr.Logger) Catalog {
+	targetsByName := make(map[string]CatalogEntry)
+	for _, target := range targets {
+		targetsByName[target.FileName] = target
+	}
+	var entries []CatalogEntry
+	for _, file := range backupFileNames {
+		date, err := parseDateInBackupFile(file)
+		if err != nil {
+			logger.Error(err, "error parsing backup date. Skipping", "file", file)
+			continue
+		}
+		if target, ok := targetsByName[file]; ok {
+			entries = append(entries, target)
+			continue
+		}
+		entries = append(entries, CatalogEntry{
+			FileName: file,
+			Time:     date,
+			Database: ParseDatabaseInBackupFile(file),
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+	if limit >= 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return Catalog{
+		Targets: targets,
+		Backups: entries,
+	}
+}
+
+// NewCatalogSummary summarizes the target backups taken by the Job, which completed at the given time.
+// The duration is measured from the date of the oldest target backup, which is set when the backup starts.
+func NewCatalogSummary(targets []CatalogEntry, fileCount int, completionTime time.Time) CatalogSummary {
+	summary := CatalogSummary{
+		FileCount:      fileCount,
+		CompletionTime: completionTime,
+	}
+	var startTime time.Time
+	for _, target := range targets {
+		summary.Size += target.Size
+		if startTime.IsZero() || target.Time.Before(startTime) {
+			startTime = target.Time
+		}
+	}
+	if !startTime.IsZero() && completionTime.After(startTime) {
+		summary.DurationSeconds = completionTime.Sub(startTime).Seconds()
+	}
+	return summary
+}
+
+// NewCatalogEntry describes a backup file available in the local filesystem.
+func NewCatalogEntry(filePath string) (CatalogEntry, error) {
+	fileName := filepath.Base(filePath)
+	date, err := parseDateInBackupFile(fileName)
+	if err != nil {
+		return CatalogEntry{}, err
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return CatalogEntry{}, fmt.Errorf("error getting file info: %v", err)
+	}
+	return CatalogEntry{
+		FileName: fileName,
+		Time:     date,
+		Database: ParseDatabaseInBackupFile(fileName),
+		Size:     info.Size(),
+	}, nil
+}
+
+// NewCatalogEntryFromManifest describes a backup file by its manifest, which allows describing backup files not available locally.
+func NewCatalogEntryFromManifest(manifest *Manifest) (CatalogEntry, error) {
+	date, err := parseDateInBackupFile(manifest.FileName)
+	if err != nil {
+		return CatalogEntry{}, err
+	}
+	return CatalogEntry{
+		FileName: manifest.FileName,
+		Time:     date,
+		Database: ParseDatabaseInBackupFile(manifest.FileName),
+		Size:     manifest.Size,
+		GTID:     manifest.GTID,
+	}, nil
+}
+
+// ReadGtid reads the GTID position written by the backup container. An empty GTID is returned if the file does not exist.
+func ReadGtid(gtidFilePath string) (string, error) {
+	bytes, err := os.ReadFile(gtidFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error reading GTID file: %v", err)
+	}
+	return strings.TrimSpace(string(bytes)), nil
+}
+
+// WriteCatalog writes the Catalog as JSON in the given path. The oldest backups, followed by the last targets,
+// are dropped until the Catalog fits in MaxCatalogSize bytes.
+func WriteCatalog(catalog Catalog, path string) error {
+	bytes, err := marshalCatalog(catalog, MaxCatalogSize)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bytes, 0644)
+}
+
+func marshalCatalog(catalog Catalog, maxSize int) ([]byte, error) {
+	for {
+		bytes, err := json.Marshal(catalog)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling catalog: %v", err)
+		}
+		if len(bytes) <= maxSize {
+			return bytes, nil
+		}
+		switch {
+		case len(catalog.Backups) > 0:
+			catalog.Backups = catalog.Backups[:len(catalog.Backups)-1]
+		case len(catalog.Targets) > 0:
+			catalog.Targets = catalog.Targets[:len(catalog.Targets)-1]
+		default:
+			return nil, fmt.Errorf("catalog exceeds the maximum size of %d bytes", maxSize)
+		}
+	}
+}
+
+// ParseCatalog parses a Catalog from its JSON representation.
+func ParseCatalog(raw string) (*Catalog, error) {
+	var catalog Catalog
+	if err := json.Unmarshal([]byte(raw), &catalog); err != nil {
+		return nil, fmt.Errorf("error unmarshaling catalog: %v", err)
+	}
+	return &catalog, nil
+}
@@ -0,0 +1,355 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewCatalog(t *testing.T) {
+	target := CatalogEntry{
+		FileName: "backup.2023-12-22T15:00:00Z.sql.gz",
+		Time:     mustParseDate(t, "2023-12-22T15:00:00Z"),
+		Size:     1024,
+		GTID:     "0-1-5",
+	}
+	tests := []struct {
+		name        string
+		backupFiles []string
+		limit       int
+		wantBackups []CatalogEntry
+	}{
+		{
+			name:        "no backups",
+			backupFiles: nil,
+			limit:       10,
+			wantBackups: nil,
+		},
+		{
+			name: "invalid backups",
+			backupFiles: []string{
+				"backup.foo.sql",
+				"backup.bar.sql",
+				"backup.sql",
+			},
+			limit:       10,
+			wantBackups: nil,
+		},
+		{
+			name: "sorted backups",
+			backupFiles: []string{
+				"backup.2023-12-22T13:00:00Z.sql.gz",
+				"backup.2023-12-22T15:00:00Z.sql.gz",
+				"backup.2023-12-22T14:00:00Z.sql.gz",
+				"backup.foo.sql",
+			},
+			limit: 10,
+			wantBackups: []CatalogEntry{
+				target,
+				{
+					FileName: "backup.2023-12-22T14:00:00Z.sql.gz",
+					Time:     mustParseDate(t, "2023-12-22T14:00:00Z"),
+				},
+				{
+					FileName: "backup.2023-12-22T13:00:00Z.sql.gz",
+					Time:     mustParseDate(t, "2023-12-22T13:00:00Z"),
+				},
+			},
+		},
+		{
+			name: "limited backups",
+			backupFiles: []string{
+				"backup.2023-12-22T13:00:00Z.sql.gz",
+				"backup.2023-12-22T14:00:00Z.sql.gz",
+				"backup.2023-12-22T15:00:00Z.sql.gz",
+			},
+			limit: 2,
+			wantBackups: []CatalogEntry{
+				target,
+				{
+					FileName: "backup.2023-12-22T14:00:00Z.sql.gz",
+					Time:     mustParseDate(t, "2023-12-22T14:00:00Z"),
+				},
+			},
+		},
+		{
+			name: "per-database backups",
+			backupFiles: []string{
+				"backup.2023-12-22T14:00:00Z.app.sql.gz",
+				"backup.2023-12-22T14:00:00Z.billing.sql.gz",
+			},
+			limit: 10,
+			wantBackups: []CatalogEntry{
+				{
+					FileName: "backup.2023-12-22T14:00:00Z.app.sql.gz",
+					Time:     mustParseDate(t, "2023-12-22T14:00:00Z"),
+					Database: "app",
+				},
+				{
+					FileName: "backup.2023-12-22T14:00:00Z.billing.sql.gz",
+					Time:     mustParseDate(t, "2023-12-22T14:00:00Z"),
+					Database: "billing",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := NewCatalog([]CatalogEntry{target}, tt.backupFiles, tt.limit, logger)
+			if !reflect.DeepEqual([]CatalogEntry{target}, catalog.Targets) {
+				t.Fatalf("unexpected targets, expected: %v got: %v", []CatalogEntry{target}, catalog.Targets)
+			}
+			if !reflect.DeepEqual(tt.wantBackups, catalog.Backups) {
+				t.Fatalf("unexpected backups, expected: %v got: %v", tt.wantBackups, catalog.Backups)
+			}
+		})
+	}
+}
+
+func TestNewCatalogSummary(t *testing.T) {
+	completionTime := time.Date(2023, 12, 22, 15, 5, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		targets     []CatalogEntry
+		fileCount   int
+		wantSummary CatalogSummary
+	}{
+		{
+			name:      "no targets",
+			targets:   nil,
+			fileCount: 3,
+			wantSummary: CatalogSummary{
+				FileCount:      3,
+				CompletionTime: completionTime,
+			},
+		},
+		{
+			name: "single target",
+			targets: []CatalogEntry{
+				{
+					FileName: "backup.2023-12-22T15:00:00Z.sql",
+					Time:     time.Date(2023, 12, 22, 15, 0, 0, 0, time.UTC),
+					Size:     1024,
+				},
+			},
+			fileCount: 5,
+			wantSummary: CatalogSummary{
+				DurationSeconds: 300,
+				Size:            1024,
+				FileCount:       5,
+				CompletionTime:  completionTime,
+			},
+		},
+		{
+			name: "per-database targets",
+			targets: []CatalogEntry{
+				{
+					FileName: "backup.2023-12-22T15:01:00Z.db1.sql",
+					Time:     time.Date(2023, 12, 22, 15, 1, 0, 0, time.UTC),
+					Database: "db1",
+					Size:     100,
+				},
+				{
+					FileName: "backup.2023-12-22T15:00:00Z.db2.sql",
+					Time:     time.Date(2023, 12, 22, 15, 0, 0, 0, time.UTC),
+					Database: "db2",
+					Size:     200,
+				},
+			},
+			fileCount: 2,
+			wantSummary: CatalogSummary{
+				DurationSeconds: 300,
+				Size:            300,
+				FileCount:       2,
+				CompletionTime:  completionTime,
+			},
+		},
+		{
+			name: "target after completion",
+			targets: []CatalogEntry{
+				{
+					FileName: "backup.2023-12-22T16:00:00Z.sql",
+					Time:     time.Date(2023, 12, 22, 16, 0, 0, 0, time.UTC),
+					Size:     10,
+				},
+			},
+			fileCount: 1,
+			wantSummary: CatalogSummary{
+				Size:           10,
+				FileCount:      1,
+				CompletionTime: completionTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := NewCatalogSummary(tt.targets, tt.fileCount, completionTime)
+			if !reflect.DeepEqual(tt.wantSummary, summary) {
+				t.Fatalf("unexpected summary, expected: %v got: %v", tt.wantSummary, summary)
+			}
+		})
+	}
+}
+
+func TestCatalogRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	backupFile := filepath.Join(dir, "backup.2023-12-22T15:00:00Z.sql")
+	if err := os.WriteFile(backupFile, []byte("CREATE DATABASE test;"), 0644); err != nil {
+		t.Fatalf("unexpected error writing backup file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, GtidFile), []byte("0-1-5\n"), 0644); err != nil {
+		t.Fatalf("unexpected error writing GTID file: %v", err)
+	}
+
+	target, err := NewCatalogEntry(backupFile)
+	if err != nil {
+		t.Fatalf("unexpected error describing backup file: %v", err)
+	}
+	if target.FileName != "backup.2023-12-22T15:00:00Z.sql" || target.Size != 21 {
+		t.Fatalf("unexpected catalog entry: %v", target)
+	}
+	target.GTID, err = ReadGtid(filepath.Join(dir, GtidFile))
+	if err != nil {
+		t.Fatalf("unexpected error reading GTID: %v", err)
+	}
+	if target.GTID != "0-1-5" {
+		t.Fatalf("unexpected GTID, expected: %s got: %s", "0-1-5", target.GTID)
+	}
+
+	catalog := NewCatalog([]CatalogEntry{target}, []string{target.FileName}, 10, logger)
+	summary := NewCatalogSummary(catalog.Targets, 1, time.Date(2023, 12, 22, 15, 5, 0, 0, time.UTC))
+	catalog.Summary = &summary
+	catalogPath := filepath.Join(dir, "catalog.json")
+	if err := WriteCatalog(catalog, catalogPath); err != nil {
+		t.Fatalf("unexpected error writing catalog: %v", err)
+	}
+	bytes, err := os.ReadFile(catalogPath)
+	if err != nil {
+		t.Fatalf("unexpected error reading catalog: %v", err)
+	}
+	parsedCatalog, err := ParseCatalog(string(bytes))
+	if err != nil {
+		t.Fatalf("unexpected error parsing catalog: %v", err)
+	}
+	if !reflect.DeepEqual(catalog, *parsedCatalog) {
+		t.Fatalf("unexpected catalog, expected: %v got: %v", catalog, *parsedCatalog)
+	}
+}
+
+func TestMarshalCatalog(t *testing.T) {
+	date := time.Date(2023, 12, 22, 15, 0, 0, 0, time.UTC)
+	entries := func(count int) []CatalogEntry {
+		var entries []CatalogEntry
+		for i := 0; i < count; i++ {
+			entryDate := date.Add(-time.Duration(i) * time.Hour)
+			entries = append(entries, CatalogEntry{
+				FileName: fmt.Sprintf("backup.%s.db%d.sql", entryDate.Format(time.RFC3339), i),
+				Time:     entryDate,
+				Database: fmt.Sprintf("db%d", i),
+				Size:     1024,
+				GTID:     "0-1-5",
+			})
+		}
+		return entries
+	}
+
+	tests := []struct {
+		name        string
+		catalog     Catalog
+		maxSize     int
+		wantTargets int
+		wantBackups int
+		wantErr     bool
+	}{
+		{
+			name: "fits",
+			catalog: Catalog{
+				Targets: entries(1),
+				Backups: entries(10),
+			},
+			maxSize:     MaxCatalogSize,
+			wantTargets: 1,
+			wantBackups: 10,
+			wantErr:     false,
+		},
+		{
+			name: "backups truncated",
+			catalog: Catalog{
+				Targets: entries(1),
+				Backups: entries(100),
+			},
+			maxSize:     MaxCatalogSize,
+			wantTargets: 1,
+			wantBackups: 31,
+			wantErr:     false,
+		},
+		{
+			name: "targets truncated",
+			catalog: Catalog{
+				Targets: entries(100),
+				Backups: entries(100),
+			},
+			maxSize:     MaxCatalogSize,
+			wantTargets: 32,
+			wantBackups: 0,
+			wantErr:     false,
+		},
+		{
+			name: "summary too large",
+			catalog: Catalog{
+				Targets: entries(1),
+				Summary: &CatalogSummary{},
+			},
+			maxSize:     10,
+			wantTargets: 0,
+			wantBackups: 0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytes, err := marshalCatalog(tt.catalog, tt.maxSize)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expecting error to be non nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if len(bytes) > tt.maxSize {
+				t.Fatalf("unexpected catalog size, expected at most: %d got: %d", tt.maxSize, len(bytes))
+			}
+			catalog, err := ParseCatalog(string(bytes))
+			if err != nil {
+				t.Fatalf("unexpected error parsing catalog: %v", err)
+			}
+			if len(catalog.Targets) != tt.wantTargets {
+				t.Fatalf("unexpected number of targets, expected: %d got: %d", tt.wantTargets, len(catalog.Targets))
+			}
+			if len(catalog.Backups) != tt.wantBackups {
+				t.Fatalf("unexpected number of backups, expected: %d got: %d", tt.wantBackups, len(catalog.Backups))
+			}
+			if len(catalog.Backups) > 0 && !reflect.DeepEqual(tt.catalog.Backups[:len(catalog.Backups)], catalog.Backups) {
+				t.Fatalf("expecting the newest backups to be kept, got: %v", catalog.Backups)
+			}
+		})
+	}
+}
+
+func TestReadGtidNotFound(t *testing.T) {
+	gtid, err := ReadGtid(filepath.Join(t.TempDir(), GtidFile))
+	if err != nil {
+		t.Fatalf("unexpected error reading GTID: %v", err)
+	}
+	if gtid != "" {
+		t.Fatalf("expected empty GTID, got: %s", gtid)
+	}
+}
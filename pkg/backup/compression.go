@@ -0,0 +1,34 @@
+package backup
+
+import (
+	"strings"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+)
+
+// CompressAlgorithms returns the algorithms that compress backup files.
+func CompressAlgorithms() []mariadbv1alpha1.CompressAlgorithm {
+	return []mariadbv1alpha1.CompressAlgorithm{
+		mariadbv1alpha1.CompressGzip,
+		mariadbv1alpha1.CompressZstd,
+		mariadbv1alpha1.CompressBzip2,
+	}
+}
+
+// ParseCompressAlgorithm determines the CompressAlgorithm of a backup file by its extension.
+func ParseCompressAlgorithm(fileName string) mariadbv1alpha1.CompressAlgorithm {
+	for _, algorithm := range CompressAlgorithms() {
+		if strings.HasSuffix(fileName, "."+algorithm.Extension()) {
+			return algorithm
+		}
+	}
+	return mariadbv1alpha1.CompressNone
+}
+
+func trimCompressExtension(fileName string) string {
+	algorithm := ParseCompressAlgorithm(fileName)
+	if !algorithm.IsCompressed() {
+		return fileName
+	}
+	return strings.TrimSuffix(fileName, "."+algorithm.Extension())
+}
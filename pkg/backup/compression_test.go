@@ -0,0 +1,55 @@
+package backup
+
+import (
+	"testing"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+)
+
+func TestParseCompressAlgorithm(t *testing.T) {
+	tests := []struct {
+		name          string
+		backupFile    string
+		wantAlgorithm mariadbv1alpha1.CompressAlgorithm
+	}{
+		{
+			name:          "empty",
+			backupFile:    "",
+			wantAlgorithm: mariadbv1alpha1.CompressNone,
+		},
+		{
+			name:          "uncompressed",
+			backupFile:    "backup.2023-12-18T16:14:00Z.sql",
+			wantAlgorithm: mariadbv1alpha1.CompressNone,
+		},
+		{
+			name:          "physical",
+			backupFile:    "backup.2023-12-18T16:14:00Z.tar",
+			wantAlgorithm: mariadbv1alpha1.CompressNone,
+		},
+		{
+			name:          "gzip",
+			backupFile:    "backup.2023-12-18T16:14:00Z.sql.gz",
+			wantAlgorithm: mariadbv1alpha1.CompressGzip,
+		},
+		{
+			name:          "zstd",
+			backupFile:    "backup.2023-12-18T16:14:00Z.sql.zst",
+			wantAlgorithm: mariadbv1alpha1.CompressZstd,
+		},
+		{
+			name:          "bzip2",
+			backupFile:    "backup.2023-12-18T16:14:00Z.sql.bz2",
+			wantAlgorithm: mariadbv1alpha1.CompressBzip2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			algorithm := ParseCompressAlgorithm(tt.backupFile)
+			if tt.wantAlgorithm != algorithm {
+				t.Fatalf("unexpected compress algorithm, expected: %v got: %v", tt.wantAlgorithm, algorithm)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	// EncryptedExtension is the extension added to the backup files encrypted with EncryptFile.
+	EncryptedExtension = "enc"
+
+	encryptionKeySize   = 32
+	encryptionChunkSize = 64 * 1024
+	noncePrefixSize     = 7
+)
+
+// encryptionMagic identifies the encrypted file format, allowing to version it.
+var encryptionMagic = []byte("MDBENC01")
+
+// ParseEncryptionKey parses an AES-256 key, which must be 32 bytes long, either raw or base64/hex encoded.
+func ParseEncryptionKey(data []byte) ([]byte, error) {
+	if len(data) == encryptionKeySize {
+		return data, nil
+	}
+	trimmed := strings.TrimSpace(string(data))
+	if key, err := base64.StdEncoding.DecodeString(trimmed); err == nil && len(key) == encryptionKeySize {
+		return key, nil
+	}
+	if key, err := hex.DecodeString(trimmed); err == nil && len(key) == encryptionKeySize {
+		return key, nil
+	}
+	return nil, fmt.Errorf("encryption key must be %d bytes long, either raw or base64/hex encoded", encryptionKeySize)
+}
+
+// ReadEncryptionKey reads and parses an AES-256 key from a file.
+func ReadEncryptionKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading encryption key: %v", err)
+	}
+	return ParseEncryptionKey(data)
+}
+
+// IsEncryptedBackupFile determines whether a backup file has been encrypted by its extension.
+func IsEncryptedBackupFile(fileName string) bool {
+	return strings.HasSuffix(fileName, "."+EncryptedExtension)
+}
+
+// EncryptedBackupFile returns the name of a backup file once encrypted.
+func EncryptedBackupFile(fileName string) string {
+	if IsEncryptedBackupFile(fileName) {
+		return fileName
+	}
+	return fmt.Sprintf("%s.%s", fileName, EncryptedExtension)
+}
+
+// DecryptedBackupFile returns the name of a backup file once decrypted.
+func DecryptedBackupFile(fileName string) string {
+	return strings.TrimSuffix(fileName, "."+EncryptedExtension)
+}
+
+// EncryptFile encrypts the src file into the dst file using AES-256-GCM.
+func EncryptFile(src, dst string, key []byte) error {
+	return transformFile(src, dst, key, Encrypt)
+}
+
+// DecryptFile decrypts the src file, previously encrypted with EncryptFile, into the dst file.
+func DecryptFile(src, dst string, key []byte) error {
+	return transformFile(src, dst, key, Decrypt)
+}
+
+// Encrypt encrypts the data read from src into dst using AES-256-GCM. The data is split in chunks which are
+// authenticated independently, in a way that reordering or truncating them is detected when decrypting.
+func Encrypt(dst io.Writer, src io.Reader, key []byte) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return fmt.Errorf("error generating nonce: %v", err)
+	}
+	if _, err := dst.Write(append(bytes.Clone(encryptionMagic), noncePrefix...)); err != nil {
+		return fmt.Errorf("error writing header: %v", err)
+	}
+
+	reader := bufio.NewReader(src)
+	buf := make([]byte, encryptionChunkSize)
+	for counter := uint32(0); ; counter++ {
+		n, last, err := readChunk(reader, buf)
+		if err != nil {
+			return fmt.Errorf("error reading chunk: %v", err)
+		}
+		sealed := aead.Seal(nil, chunkNonce(noncePrefix, counter, last), buf[:n], nil)
+		if _, err := dst.Write(sealed); err != nil {
+			return fmt.Errorf("error writing chunk: %v", err)
+		}
+		if last {
+			return nil
+		}
+		if counter == math.MaxUint32 {
+			return errors.New("maximum number of chunks exceeded")
+		}
+	}
+}
+
+// Decrypt decrypts the data read from src, previously encrypted with Encrypt, into dst.
+func Decrypt(dst io.Writer, src io.Reader, key []byte) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	header := make([]byte, len(encryptionMagic)+noncePrefixSize)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return fmt.Errorf("error reading header: %v", err)
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], encryptionMagic) {
+		return errors.New("invalid header, data has not been encrypted by this module")
+	}
+	noncePrefix := header[len(encryptionMagic):]
+
+	reader := bufio.NewReader(src)
+	buf := make([]byte, encryptionChunkSize+aead.Overhead())
+	for counter := uint32(0); ; counter++ {
+		n, last, err := readChunk(reader, buf)
+		if err != nil {
+			return fmt.Errorf("error reading chunk: %v", err)
+		}
+		plaintext, err := aead.Open(nil, chunkNonce(noncePrefix, counter, last), buf[:n], nil)
+		if err != nil {
+			return fmt.Errorf("error decrypting chunk %d: %v", counter, err)
+		}
+		if _, err := dst.Write(plaintext); err != nil {
+			return fmt.Errorf("error writing chunk: %v", err)
+		}
+		if last {
+			return nil
+		}
+		if counter == math.MaxUint32 {
+			return errors.New("maximum number of chunks exceeded")
+		}
+	}
+}
+
+func transformFile(src, dst string, key []byte, transform func(io.Writer, io.Reader, []byte) error) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("error opening file: %v", err)
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("error creating file: %v", err)
+	}
+	writer := bufio.NewWriter(dstFile)
+	if err := transform(writer, srcFile, key); err != nil {
+		dstFile.Close()
+		os.Remove(dst)
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		dstFile.Close()
+		return fmt.Errorf("error flushing file: %v", err)
+	}
+	return dstFile.Close()
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("invalid encryption key size: %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// readChunk fills buf and reports whether the chunk is the last one by peeking into the reader.
+func readChunk(reader *bufio.Reader, buf []byte) (int, bool, error) {
+	n, err := io.ReadFull(reader, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return n, true, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if _, err := reader.Peek(1); err != nil {
+		if err == io.EOF {
+			return n, true, nil
+		}
+		return 0, false, err
+	}
+	return n, false, nil
+}
+
+func chunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
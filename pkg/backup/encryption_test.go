@@ -0,0 +1,196 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseEncryptionKey(t *testing.T) {
+	key := mustRandomBytes(t, encryptionKeySize)
+	tests := []struct {
+		name    string
+		data    []byte
+		wantKey []byte
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			data:    nil,
+			wantKey: nil,
+			wantErr: true,
+		},
+		{
+			name:    "raw",
+			data:    key,
+			wantKey: key,
+			wantErr: false,
+		},
+		{
+			name:    "base64",
+			data:    []byte(base64.StdEncoding.EncodeToString(key) + "\n"),
+			wantKey: key,
+			wantErr: false,
+		},
+		{
+			name:    "hex",
+			data:    []byte(hex.EncodeToString(key)),
+			wantKey: key,
+			wantErr: false,
+		},
+		{
+			name:    "too short",
+			data:    []byte(base64.StdEncoding.EncodeToString(key[:16])),
+			wantKey: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParseEncryptionKey(tt.data)
+			if tt.wantErr && err == nil {
+				t.Fatal("expect error to have occurred, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expect error to not have occurred, got: %v", err)
+			}
+			if !bytes.Equal(tt.wantKey, key) {
+				t.Fatalf("unexpected key, expected: %v got: %v", tt.wantKey, key)
+			}
+		})
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := mustRandomBytes(t, encryptionKeySize)
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty",
+			data: []byte{},
+		},
+		{
+			name: "small",
+			data: []byte("CREATE DATABASE test;"),
+		},
+		{
+			name: "chunk size",
+			data: mustRandomBytes(t, encryptionChunkSize),
+		},
+		{
+			name: "multiple chunks",
+			data: mustRandomBytes(t, 3*encryptionChunkSize+100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var encrypted bytes.Buffer
+			if err := Encrypt(&encrypted, bytes.NewReader(tt.data), key); err != nil {
+				t.Fatalf("unexpected error encrypting: %v", err)
+			}
+			if len(tt.data) > 0 && bytes.Contains(encrypted.Bytes(), tt.data) {
+				t.Fatal("expected data to be encrypted")
+			}
+
+			var decrypted bytes.Buffer
+			if err := Decrypt(&decrypted, bytes.NewReader(encrypted.Bytes()), key); err != nil {
+				t.Fatalf("unexpected error decrypting: %v", err)
+			}
+			if !bytes.Equal(tt.data, decrypted.Bytes()) {
+				t.Fatal("expected decrypted data to match the original data")
+			}
+		})
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key := mustRandomBytes(t, encryptionKeySize)
+	var encrypted bytes.Buffer
+	if err := Encrypt(&encrypted, bytes.NewReader(mustRandomBytes(t, 2*encryptionChunkSize+100)), key); err != nil {
+		t.Fatalf("unexpected error encrypting: %v", err)
+	}
+	data := encrypted.Bytes()
+	tampered := bytes.Clone(data)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name string
+		data []byte
+		key  []byte
+	}{
+		{
+			name: "unencrypted",
+			data: []byte("CREATE DATABASE test;"),
+			key:  key,
+		},
+		{
+			name: "wrong key",
+			data: data,
+			key:  mustRandomBytes(t, encryptionKeySize),
+		},
+		{
+			name: "tampered",
+			data: tampered,
+			key:  key,
+		},
+		{
+			name: "truncated",
+			data: data[:len(encryptionMagic)+noncePrefixSize+encryptionChunkSize+16],
+			key:  key,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Decrypt(&bytes.Buffer{}, bytes.NewReader(tt.data), tt.key); err == nil {
+				t.Fatal("expect error to have occurred, got nil")
+			}
+		})
+	}
+}
+
+func TestEncryptDecryptFile(t *testing.T) {
+	key := mustRandomBytes(t, encryptionKeySize)
+	dir := t.TempDir()
+	data := []byte("CREATE DATABASE test;")
+
+	src := filepath.Join(dir, "backup.2023-12-18T16:14:00Z.sql")
+	if err := os.WriteFile(src, data, 0644); err != nil {
+		t.Fatalf("unexpected error writing file: %v", err)
+	}
+	encrypted := filepath.Join(dir, EncryptedBackupFile(filepath.Base(src)))
+	if err := EncryptFile(src, encrypted, key); err != nil {
+		t.Fatalf("unexpected error encrypting file: %v", err)
+	}
+	if !IsEncryptedBackupFile(encrypted) {
+		t.Fatalf("expected file to be encrypted: %s", encrypted)
+	}
+
+	decrypted := filepath.Join(t.TempDir(), DecryptedBackupFile(filepath.Base(encrypted)))
+	if err := DecryptFile(encrypted, decrypted, key); err != nil {
+		t.Fatalf("unexpected error decrypting file: %v", err)
+	}
+	bytes, err := os.ReadFile(decrypted)
+	if err != nil {
+		t.Fatalf("unexpected error reading file: %v", err)
+	}
+	if string(data) != string(bytes) {
+		t.Fatalf("unexpected decrypted data, expected: %s got: %s", data, bytes)
+	}
+}
+
+func mustRandomBytes(t *testing.T, size int) []byte {
+	bytes := make([]byte, size)
+	if _, err := rand.Read(bytes); err != nil {
+		t.Fatalf("unexpected error generating random bytes: %v", err)
+	}
+	return bytes
+}
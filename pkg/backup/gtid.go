@@ -0,0 +1,167 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	binlogEventHeaderSize = 19
+	binlogGtidEvent       = 162
+	binlogGtidListEvent   = 163
+	binlogGtidListEntry   = 16
+	// binlogMaxHeaderEvents bounds the number of events read until finding the Gtid_list event.
+	binlogMaxHeaderEvents = 8
+)
+
+var binlogMagic = []byte{0xfe, 'b', 'i', 'n'}
+
+// Gtid is a MariaDB global transaction ID.
+type Gtid struct {
+	Domain   uint32
+	ServerId uint32
+	Sequence uint64
+}
+
+func (g Gtid) String() string {
+	return fmt.Sprintf("%d-%d-%d", g.Domain, g.ServerId, g.Sequence)
+}
+
+// GtidPosition is a GTID position, composed by the last GTID of every replication domain.
+type GtidPosition map[uint32]Gtid
+
+// ParseGtidPosition parses a comma separated GTID position, as returned by @@gtid_binlog_pos.
+func ParseGtidPosition(rawPos string) (GtidPosition, error) {
+	pos := make(GtidPosition)
+	for _, rawGtid := range strings.Split(strings.TrimSpace(rawPos), ",") {
+		rawGtid = strings.TrimSpace(rawGtid)
+		if rawGtid == "" {
+			continue
+		}
+		parts := strings.Split(rawGtid, "-")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid GTID: %s", rawGtid)
+		}
+		domain, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GTID domain: %s", rawGtid)
+		}
+		serverId, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GTID server ID: %s", rawGtid)
+		}
+		sequence, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GTID sequence: %s", rawGtid)
+		}
+		pos[uint32(domain)] = Gtid{
+			Domain:   uint32(domain),
+			ServerId: uint32(serverId),
+			Sequence: sequence,
+		}
+	}
+	if len(pos) == 0 {
+		return nil, errors.New("GTID position is empty")
+	}
+	return pos, nil
+}
+
+// After determines whether the position is ahead of another position in any of the replication domains of the latter.
+func (p GtidPosition) After(other GtidPosition) bool {
+	for domain, otherGtid := range other {
+		if gtid, ok := p[domain]; ok && gtid.Sequence > otherGtid.Sequence {
+			return true
+		}
+	}
+	return false
+}
+
+func (p GtidPosition) String() string {
+	domains := make([]uint32, 0, len(p))
+	for domain := range p {
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i] < domains[j]
+	})
+	gtids := make([]string, len(domains))
+	for i, domain := range domains {
+		gtids[i] = p[domain].String()
+	}
+	return strings.Join(gtids, ",")
+}
+
+// ReadBinlogGtidPosition reads the GTID position at the beginning of a binary log file,
+// recorded by the server in the Gtid_list event that follows the format description event.
+func ReadBinlogGtidPosition(binlogPath string) (GtidPosition, error) {
+	file, err := os.Open(binlogPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening binlog: %v", err)
+	}
+	defer file.Close()
+	return readBinlogGtidPosition(bufio.NewReader(file))
+}
+
+func readBinlogGtidPosition(reader io.Reader) (GtidPosition, error) {
+	magic := make([]byte, len(binlogMagic))
+	if _, err := io.ReadFull(reader, magic); err != nil {
+		return nil, fmt.Errorf("error reading binlog magic number: %v", err)
+	}
+	if !bytes.Equal(magic, binlogMagic) {
+		return nil, errors.New("invalid binlog magic number")
+	}
+
+	header := make([]byte, binlogEventHeaderSize)
+	for i := 0; i < binlogMaxHeaderEvents; i++ {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			return nil, fmt.Errorf("error reading binlog event header: %v", err)
+		}
+		eventType := header[4]
+		eventSize := binary.LittleEndian.Uint32(header[9:13])
+		if eventSize < binlogEventHeaderSize {
+			return nil, fmt.Errorf("invalid binlog event size: %d", eventSize)
+		}
+		body := make([]byte, eventSize-binlogEventHeaderSize)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			return nil, fmt.Errorf("error reading binlog event: %v", err)
+		}
+
+		switch eventType {
+		case binlogGtidListEvent:
+			return parseGtidListEvent(body)
+		case binlogGtidEvent:
+			return nil, errors.New("Gtid_list event not found before the first GTID event")
+		}
+	}
+	return nil, errors.New("Gtid_list event not found in binlog header")
+}
+
+func parseGtidListEvent(body []byte) (GtidPosition, error) {
+	if len(body) < 4 {
+		return nil, errors.New("invalid Gtid_list event")
+	}
+	count := int(binary.LittleEndian.Uint32(body[0:4]) & 0x0fffffff)
+	entries := body[4:]
+	if len(entries) < count*binlogGtidListEntry {
+		return nil, fmt.Errorf("invalid Gtid_list event: expected %d GTIDs", count)
+	}
+	pos := make(GtidPosition, count)
+	for i := 0; i < count; i++ {
+		entry := entries[i*binlogGtidListEntry : (i+1)*binlogGtidListEntry]
+		gtid := Gtid{
+			Domain:   binary.LittleEndian.Uint32(entry[0:4]),
+			ServerId: binary.LittleEndian.Uint32(entry[4:8]),
+			Sequence: binary.LittleEndian.Uint64(entry[8:16]),
+		}
+		pos[gtid.Domain] = gtid
+	}
+	return pos, nil
+}
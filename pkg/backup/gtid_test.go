@@ -0,0 +1,236 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseGtidPosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		rawPos  string
+		wantPos GtidPosition
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			rawPos:  "",
+			wantPos: nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			rawPos:  "0-1",
+			wantPos: nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid sequence",
+			rawPos:  "0-1-foo",
+			wantPos: nil,
+			wantErr: true,
+		},
+		{
+			name:   "single domain",
+			rawPos: "0-1-5\n",
+			wantPos: GtidPosition{
+				0: {Domain: 0, ServerId: 1, Sequence: 5},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "multiple domains",
+			rawPos: "0-1-5, 1-2-10",
+			wantPos: GtidPosition{
+				0: {Domain: 0, ServerId: 1, Sequence: 5},
+				1: {Domain: 1, ServerId: 2, Sequence: 10},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, err := ParseGtidPosition(tt.rawPos)
+			if tt.wantErr && err == nil {
+				t.Fatal("expecting error to be non nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantPos, pos) {
+				t.Fatalf("unexpected GTID position, expected: %v got: %v", tt.wantPos, pos)
+			}
+		})
+	}
+}
+
+func TestGtidPositionAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		pos       string
+		other     string
+		wantAfter bool
+	}{
+		{
+			name:      "before",
+			pos:       "0-1-5",
+			other:     "0-1-10",
+			wantAfter: false,
+		},
+		{
+			name:      "equal",
+			pos:       "0-1-10",
+			other:     "0-1-10",
+			wantAfter: false,
+		},
+		{
+			name:      "after",
+			pos:       "0-1-15",
+			other:     "0-1-10",
+			wantAfter: true,
+		},
+		{
+			name:      "after in one domain",
+			pos:       "0-1-5,1-1-15",
+			other:     "0-1-10,1-1-10",
+			wantAfter: true,
+		},
+		{
+			name:      "missing domain",
+			pos:       "1-1-15",
+			other:     "0-1-10",
+			wantAfter: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, err := ParseGtidPosition(tt.pos)
+			if err != nil {
+				t.Fatalf("unexpected error parsing GTID position: %v", err)
+			}
+			other, err := ParseGtidPosition(tt.other)
+			if err != nil {
+				t.Fatalf("unexpected error parsing GTID position: %v", err)
+			}
+			if after := pos.After(other); after != tt.wantAfter {
+				t.Fatalf("unexpected result, expected: %v got: %v", tt.wantAfter, after)
+			}
+		})
+	}
+}
+
+func TestGtidPositionString(t *testing.T) {
+	pos := GtidPosition{
+		1: {Domain: 1, ServerId: 2, Sequence: 10},
+		0: {Domain: 0, ServerId: 1, Sequence: 5},
+	}
+	if pos.String() != "0-1-5,1-2-10" {
+		t.Fatalf("unexpected GTID position, expected: %s got: %s", "0-1-5,1-2-10", pos.String())
+	}
+}
+
+func TestReadBinlogGtidPosition(t *testing.T) {
+	gtids := []Gtid{
+		{Domain: 0, ServerId: 1, Sequence: 5},
+		{Domain: 1, ServerId: 2, Sequence: 10},
+	}
+	tests := []struct {
+		name    string
+		binlog  []byte
+		wantPos GtidPosition
+		wantErr bool
+	}{
+		{
+			name:    "invalid magic",
+			binlog:  []byte("foo"),
+			wantPos: nil,
+			wantErr: true,
+		},
+		{
+			name: "no Gtid_list",
+			binlog: testBinlog(
+				testBinlogEvent(15, make([]byte, 100)),
+				testBinlogEvent(binlogGtidEvent, make([]byte, 20)),
+			),
+			wantPos: nil,
+			wantErr: true,
+		},
+		{
+			name: "truncated Gtid_list",
+			binlog: testBinlog(
+				testBinlogEvent(15, make([]byte, 100)),
+				testBinlogEvent(binlogGtidListEvent, []byte{2, 0, 0, 0}),
+			),
+			wantPos: nil,
+			wantErr: true,
+		},
+		{
+			name: "empty Gtid_list",
+			binlog: testBinlog(
+				testBinlogEvent(15, make([]byte, 100)),
+				testBinlogEvent(binlogGtidListEvent, testGtidListBody(nil)),
+			),
+			wantPos: GtidPosition{},
+			wantErr: false,
+		},
+		{
+			name: "Gtid_list",
+			binlog: testBinlog(
+				testBinlogEvent(15, make([]byte, 100)),
+				testBinlogEvent(binlogGtidListEvent, testGtidListBody(gtids)),
+				testBinlogEvent(161, make([]byte, 20)),
+			),
+			wantPos: GtidPosition{
+				0: gtids[0],
+				1: gtids[1],
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binlogPath := filepath.Join(t.TempDir(), "mariadb-bin.000001")
+			if err := os.WriteFile(binlogPath, tt.binlog, 0644); err != nil {
+				t.Fatalf("unexpected error writing binlog: %v", err)
+			}
+			pos, err := ReadBinlogGtidPosition(binlogPath)
+			if tt.wantErr && err == nil {
+				t.Fatal("expecting error to be non nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantPos, pos) {
+				t.Fatalf("unexpected GTID position, expected: %v got: %v", tt.wantPos, pos)
+			}
+		})
+	}
+}
+
+func testBinlog(events ...[]byte) []byte {
+	return append(append([]byte{}, binlogMagic...), bytes.Join(events, nil)...)
+}
+
+func testBinlogEvent(eventType byte, body []byte) []byte {
+	header := make([]byte, binlogEventHeaderSize)
+	header[4] = eventType
+	binary.LittleEndian.PutUint32(header[9:13], uint32(binlogEventHeaderSize+len(body)))
+	return append(header, body...)
+}
+
+func testGtidListBody(gtids []Gtid) []byte {
+	body := binary.LittleEndian.AppendUint32(nil, uint32(len(gtids)))
+	for _, gtid := range gtids {
+		body = binary.LittleEndian.AppendUint32(body, gtid.Domain)
+		body = binary.LittleEndian.AppendUint32(body, gtid.ServerId)
+		body = binary.LittleEndian.AppendUint64(body, gtid.Sequence)
+	}
+	return body
+}
@@ -0,0 +1,355 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// ManifestExtension is the extension of the manifest pushed along with every backup file.
+const ManifestExtension = "manifest.json"
+
+// ServerVersionFile is the file where the server version of the target backup is written by the backup container.
+const ServerVersionFile = "0-backup-version.txt"
+
+// Manifest describes a backup file, allowing to check its integrity before restoring it.
+type Manifest struct {
+	// FileName is the name of the backup file described by the manifest.
+	FileName string `json:"fileName"`
+	// SHA256 is the hex encoded SHA-256 digest of the backup file, as pushed to the storage.
+	SHA256 string `json:"sha256"`
+	// Size is the size of the backup file in bytes.
+	Size int64 `json:"size"`
+	// DumpOptions are the options passed to the tool that took the backup.
+	DumpOptions string `json:"dumpOptions,omitempty"`
+	// ServerVersion is the version of the server the backup was taken from.
+	ServerVersion string `json:"serverVersion,omitempty"`
+	// GTID is the GTID position of the backup.
+	GTID string `json:"gtid,omitempty"`
+	// Time is the time when the manifest was created.
+	Time time.Time `json:"time"`
+}
+
+// NewManifest describes a backup file available in the local filesystem by its digest and size.
+func NewManifest(filePath string) (Manifest, error) {
+	digest, size, err := fileDigest(filePath)
+	if err != nil {
+		return Manifest{}, err
+	}
+	return Manifest{
+		FileName: filepath.Base(filePath),
+		SHA256:   digest,
+		Size:     size,
+		Time:     time.Now().UTC(),
+	}, nil
+}
+
+// Validate determines whether the manifest is well formed.
+func (m *Manifest) Validate() error {
+	if m.FileName == "" {
+		return errors.New("file name must be set")
+	}
+	if !IsValidBackupFile(m.FileName) {
+		return fmt.Errorf("invalid backup file name: %s", m.FileName)
+	}
+	if digest, err := hex.DecodeString(m.SHA256); err != nil || len(digest) != sha256.Size {
+		return fmt.Errorf("invalid SHA-256 digest: '%s'", m.SHA256)
+	}
+	return nil
+}
+
+// Verify checks that the backup file available in the local filesystem matches the digest of the manifest.
+func (m *Manifest) Verify(filePath string) error {
+	digest, size, err := fileDigest(filePath)
+	if err != nil {
+		return err
+	}
+	return m.verifyDigest(digest, size)
+}
+
+func (m *Manifest) verifyDigest(digest string, size int64) error {
+	if digest != m.SHA256 {
+		return fmt.Errorf(
+			"checksum mismatch for backup file %s: expected SHA-256 %s, got %s. The backup file might be corrupted",
+			m.FileName,
+			m.SHA256,
+			digest,
+		)
+	}
+	if size != m.Size {
+		return fmt.Errorf("size mismatch for backup file %s: expected %d bytes, got %d", m.FileName, m.Size, size)
+	}
+	return nil
+}
+
+// ManifestFile returns the name of the manifest of a backup file.
+func ManifestFile(fileName string) string {
+	return fmt.Sprintf("%s.%s", fileName, ManifestExtension)
+}
+
+// IsValidManifestFile determines whether a file name is the manifest of a valid backup file.
+func IsValidManifestFile(fileName string) bool {
+	backupFile, ok := strings.CutSuffix(fileName, "."+ManifestExtension)
+	return ok && IsValidBackupFile(backupFile)
+}
+
+// IsValidBackupOrManifestFile determines whether a file name is either a valid backup file or its manifest.
+func IsValidBackupOrManifestFile(fileName string) bool {
+	return IsValidBackupFile(fileName) || IsValidManifestFile(fileName)
+}
+
+// WriteManifest writes the Manifest as JSON in the given path.
+func WriteManifest(manifest Manifest, path string) error {
+	bytes, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("error marshaling manifest: %v", err)
+	}
+	return os.WriteFile(path, bytes, 0644)
+}
+
+// ReadManifest reads and validates the Manifest available in the given path.
+func ReadManifest(path string) (*Manifest, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %v", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(bytes, &manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshaling manifest: %v", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %v", err)
+	}
+	return &manifest, nil
+}
+
+// ReadServerVersion reads the server version written by the backup container. An empty version is returned if the file does not exist.
+func ReadServerVersion(versionFilePath string) (string, error) {
+	bytes, err := os.ReadFile(versionFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error reading server version file: %v", err)
+	}
+	return strings.TrimSpace(string(bytes)), nil
+}
+
+// ManifestBackupStorage is a BackupStorage that pushes a manifest along with every backup file, verifying
+// the integrity of the backup files when pulling them. The underlying BackupStorage is expected to process
+// both backup files and manifests.
+type ManifestBackupStorage struct {
+	BackupStorage
+	basePath  string
+	manifests map[string]*Manifest
+	logger    logr.Logger
+}
+
+func NewManifestBackupStorage(storage BackupStorage, basePath string, logger logr.Logger) *ManifestBackupStorage {
+	return &ManifestBackupStorage{
+		BackupStorage: storage,
+		basePath:      basePath,
+		manifests:     make(map[string]*Manifest),
+		logger:        logger,
+	}
+}
+
+// List lists the backup files available in the storage, ignoring the ones whose manifest is missing or broken.
+// The manifests pulled when listing are kept, so they are not pulled again when pulling the backup files.
+func (m *ManifestBackupStorage) List(ctx context.Context) ([]string, error) {
+	fileNames, err := m.BackupStorage.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifests := make(map[string]bool)
+	for _, fileName := range fileNames {
+		if IsValidManifestFile(fileName) {
+			manifests[fileName] = true
+		}
+	}
+
+	var backupFileNames []string
+	for _, fileName := range fileNames {
+		if !IsValidBackupFile(fileName) {
+			continue
+		}
+		if !manifests[ManifestFile(fileName)] {
+			m.logger.Info("manifest not found. Ignoring backup file", "file", fileName)
+			continue
+		}
+		manifest, err := m.pullManifest(ctx, fileName)
+		if err != nil {
+			m.logger.Info("error getting manifest. Ignoring backup file", "file", fileName, "err", err)
+			continue
+		}
+		m.manifests[fileName] = manifest
+		backupFileNames = append(backupFileNames, fileName)
+	}
+	return backupFileNames, nil
+}
+
+// Push pushes the backup file followed by its manifest, which is expected to be available locally.
+func (m *ManifestBackupStorage) Push(ctx context.Context, fileName string) error {
+	if _, err := ReadManifest(filepath.Join(m.basePath, ManifestFile(fileName))); err != nil {
+		return fmt.Errorf("error getting manifest: %v", err)
+	}
+	if err := m.BackupStorage.Push(ctx, fileName); err != nil {
+		return err
+	}
+	if err := m.BackupStorage.Push(ctx, ManifestFile(fileName)); err != nil {
+		return fmt.Errorf("error pushing manifest: %v", err)
+	}
+	return nil
+}
+
+// Pull pulls the backup file and verifies it against the digest of its manifest.
+func (m *ManifestBackupStorage) Pull(ctx context.Context, fileName string) error {
+	manifest, err := m.getManifest(ctx, fileName)
+	if err != nil {
+		return fmt.Errorf("error getting manifest: %v", err)
+	}
+	if err := m.BackupStorage.Pull(ctx, fileName); err != nil {
+		return err
+	}
+	m.logger.V(1).Info("verifying backup file", "file", fileName, "sha256", manifest.SHA256)
+	return manifest.Verify(filepath.Join(m.basePath, fileName))
+}
+
+// PushStream pushes the backup file read from the stream followed by its manifest, which is completed with the digest
+// and size of the stream. The underlying BackupStorage must support streaming.
+func (m *ManifestBackupStorage) PushStream(ctx context.Context, manifest *Manifest, reader io.Reader) error {
+	storage, ok := m.BackupStorage.(StreamingBackupStorage)
+	if !ok {
+		return errors.New("backup storage does not support streaming")
+	}
+	hash := sha256.New()
+	counter := &byteCounter{}
+	if err := storage.PushStream(ctx, manifest.FileName, io.TeeReader(reader, io.MultiWriter(hash, counter))); err != nil {
+		return err
+	}
+	manifest.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	manifest.Size = counter.count
+
+	manifestFile := ManifestFile(manifest.FileName)
+	if err := WriteManifest(*manifest, filepath.Join(m.basePath, manifestFile)); err != nil {
+		return fmt.Errorf("error writing manifest: %v", err)
+	}
+	if err := storage.Push(ctx, manifestFile); err != nil {
+		return fmt.Errorf("error pushing manifest: %v", err)
+	}
+	return nil
+}
+
+// PullStream pulls the backup file as a stream, which is verified against the digest of its manifest once it has been read.
+// The underlying BackupStorage must support streaming.
+func (m *ManifestBackupStorage) PullStream(ctx context.Context, fileName string) (io.ReadCloser, error) {
+	storage, ok := m.BackupStorage.(StreamingBackupStorage)
+	if !ok {
+		return nil, errors.New("backup storage does not support streaming")
+	}
+	manifest, err := m.getManifest(ctx, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest: %v", err)
+	}
+	reader, err := storage.PullStream(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &verifyingReader{
+		ReadCloser: reader,
+		manifest:   manifest,
+		hash:       sha256.New(),
+	}, nil
+}
+
+// Delete deletes the backup file along with its manifest.
+func (m *ManifestBackupStorage) Delete(ctx context.Context, fileName string) error {
+	if err := m.BackupStorage.Delete(ctx, fileName); err != nil {
+		return err
+	}
+	if err := m.BackupStorage.Delete(ctx, ManifestFile(fileName)); err != nil {
+		return fmt.Errorf("error deleting manifest: %v", err)
+	}
+	delete(m.manifests, fileName)
+	return nil
+}
+
+// getManifest returns the manifest of a backup file, which is only pulled if it has not been pulled when listing.
+func (m *ManifestBackupStorage) getManifest(ctx context.Context, fileName string) (*Manifest, error) {
+	if manifest, ok := m.manifests[fileName]; ok {
+		return manifest, nil
+	}
+	return m.pullManifest(ctx, fileName)
+}
+
+func (m *ManifestBackupStorage) pullManifest(ctx context.Context, fileName string) (*Manifest, error) {
+	manifestFile := ManifestFile(fileName)
+	if err := m.BackupStorage.Pull(ctx, manifestFile); err != nil {
+		return nil, fmt.Errorf("error pulling manifest: %v", err)
+	}
+	manifest, err := ReadManifest(filepath.Join(m.basePath, manifestFile))
+	if err != nil {
+		return nil, err
+	}
+	if manifest.FileName != fileName {
+		return nil, fmt.Errorf("manifest describes a different backup file: %s", manifest.FileName)
+	}
+	return manifest, nil
+}
+
+// verifyingReader verifies the stream against the digest of the manifest when reaching the end of it,
+// returning an error instead of io.EOF on mismatch.
+type verifyingReader struct {
+	io.ReadCloser
+	manifest *Manifest
+	hash     hash.Hash
+	size     int64
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.ReadCloser.Read(p)
+	v.hash.Write(p[:n])
+	v.size += int64(n)
+	if err != io.EOF {
+		return n, err
+	}
+	if verifyErr := v.manifest.verifyDigest(hex.EncodeToString(v.hash.Sum(nil)), v.size); verifyErr != nil {
+		return n, verifyErr
+	}
+	return n, err
+}
+
+type byteCounter struct {
+	count int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.count += int64(len(p))
+	return len(p), nil
+}
+
+func fileDigest(filePath string) (string, int64, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, fmt.Errorf("error opening file: %v", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, fmt.Errorf("error calculating digest: %v", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
@@ -0,0 +1,252 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestManifestBackupStorage(t *testing.T) {
+	ctx := context.Background()
+	basePath := t.TempDir()
+	storage := NewManifestBackupStorage(
+		NewFileSystemBackupStorage(basePath, logger, WithFileSystemFileFilter(IsValidBackupOrManifestFile)),
+		basePath,
+		logger,
+	)
+
+	backupFile := "backup.2023-12-18T16:14:00Z.sql"
+	writeTestFile(t, filepath.Join(basePath, backupFile), "CREATE DATABASE test;")
+	manifest, err := NewManifest(filepath.Join(basePath, backupFile))
+	if err != nil {
+		t.Fatalf("unexpected error creating manifest: %v", err)
+	}
+	manifest.GTID = "0-1-5"
+	manifest.ServerVersion = "10.11.6-MariaDB"
+	manifest.DumpOptions = "--single-transaction"
+	if err := WriteManifest(manifest, filepath.Join(basePath, ManifestFile(backupFile))); err != nil {
+		t.Fatalf("unexpected error writing manifest: %v", err)
+	}
+	if err := storage.Push(ctx, backupFile); err != nil {
+		t.Fatalf("unexpected error pushing backup file: %v", err)
+	}
+
+	noManifestFile := "backup.2023-12-19T16:14:00Z.sql"
+	writeTestFile(t, filepath.Join(basePath, noManifestFile), "CREATE DATABASE test;")
+	if err := storage.Push(ctx, noManifestFile); err == nil {
+		t.Fatal("expected error pushing backup file without manifest")
+	}
+
+	brokenManifestFile := "backup.2023-12-20T16:14:00Z.sql"
+	writeTestFile(t, filepath.Join(basePath, brokenManifestFile), "CREATE DATABASE test;")
+	writeTestFile(t, filepath.Join(basePath, ManifestFile(brokenManifestFile)), "{")
+
+	files, err := storage.List(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error listing backup files: %v", err)
+	}
+	wantFiles := []string{backupFile}
+	if !reflect.DeepEqual(wantFiles, files) {
+		t.Fatalf("unexpected backup files, expected: %v got: %v", wantFiles, files)
+	}
+
+	if err := storage.Pull(ctx, backupFile); err != nil {
+		t.Fatalf("unexpected error pulling backup file: %v", err)
+	}
+	if err := storage.Pull(ctx, noManifestFile); err == nil {
+		t.Fatal("expected error pulling backup file without manifest")
+	}
+	if err := storage.Pull(ctx, brokenManifestFile); err == nil {
+		t.Fatal("expected error pulling backup file with broken manifest")
+	}
+
+	writeTestFile(t, filepath.Join(basePath, backupFile), "DROP DATABASE test;")
+	err = storage.Pull(ctx, backupFile)
+	if err == nil {
+		t.Fatal("expected error pulling corrupted backup file")
+	}
+	if !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected checksum mismatch error, got: %v", err)
+	}
+
+	if err := storage.Delete(ctx, backupFile); err != nil {
+		t.Fatalf("unexpected error deleting backup file: %v", err)
+	}
+	for _, file := range []string{backupFile, ManifestFile(backupFile)} {
+		if _, err := os.Stat(filepath.Join(basePath, file)); !os.IsNotExist(err) {
+			t.Fatalf("expected file %s to be deleted", file)
+		}
+	}
+}
+
+func TestManifestBackupStorageListPullsManifestsOnce(t *testing.T) {
+	ctx := context.Background()
+	basePath := t.TempDir()
+	countingStorage := &countingBackupStorage{
+		BackupStorage: NewFileSystemBackupStorage(basePath, logger, WithFileSystemFileFilter(IsValidBackupOrManifestFile)),
+	}
+	storage := NewManifestBackupStorage(countingStorage, basePath, logger)
+
+	backupFiles := []string{"backup.2023-12-18T16:14:00Z.sql", "backup.2023-12-19T16:14:00Z.sql"}
+	for _, backupFile := range backupFiles {
+		writeTestFile(t, filepath.Join(basePath, backupFile), "CREATE DATABASE test;")
+		manifest, err := NewManifest(filepath.Join(basePath, backupFile))
+		if err != nil {
+			t.Fatalf("unexpected error creating manifest: %v", err)
+		}
+		if err := WriteManifest(manifest, filepath.Join(basePath, ManifestFile(backupFile))); err != nil {
+			t.Fatalf("unexpected error writing manifest: %v", err)
+		}
+	}
+
+	files, err := storage.List(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error listing backup files: %v", err)
+	}
+	if !reflect.DeepEqual(backupFiles, files) {
+		t.Fatalf("unexpected backup files, expected: %v got: %v", backupFiles, files)
+	}
+	for _, file := range files {
+		if err := storage.Pull(ctx, file); err != nil {
+			t.Fatalf("unexpected error pulling backup file: %v", err)
+		}
+	}
+	if err := storage.Delete(ctx, files[0]); err != nil {
+		t.Fatalf("unexpected error deleting backup file: %v", err)
+	}
+
+	if countingStorage.lists != 1 {
+		t.Fatalf("expecting the storage to be listed once, got %d lists", countingStorage.lists)
+	}
+	wantPulls := map[string]int{
+		backupFiles[0]:               1,
+		ManifestFile(backupFiles[0]): 1,
+		backupFiles[1]:               1,
+		ManifestFile(backupFiles[1]): 1,
+	}
+	if !reflect.DeepEqual(wantPulls, countingStorage.pulls) {
+		t.Fatalf("unexpected pulls, expected: %v got: %v", wantPulls, countingStorage.pulls)
+	}
+}
+
+type countingBackupStorage struct {
+	BackupStorage
+	lists int
+	pulls map[string]int
+}
+
+func (c *countingBackupStorage) List(ctx context.Context) ([]string, error) {
+	c.lists++
+	return c.BackupStorage.List(ctx)
+}
+
+func (c *countingBackupStorage) Pull(ctx context.Context, fileName string) error {
+	if c.pulls == nil {
+		c.pulls = make(map[string]int)
+	}
+	c.pulls[fileName]++
+	return c.BackupStorage.Pull(ctx, fileName)
+}
+
+func TestReadManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			manifest: `{"fileName":"backup.2023-12-18T16:14:00Z.sql.gz","sha256":"` + strings.Repeat("a", 64) + `","size":10}`,
+			wantErr:  false,
+		},
+		{
+			name:     "invalid JSON",
+			manifest: `{"fileName":`,
+			wantErr:  true,
+		},
+		{
+			name:     "missing file name",
+			manifest: `{"sha256":"` + strings.Repeat("a", 64) + `"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid file name",
+			manifest: `{"fileName":"foo.txt","sha256":"` + strings.Repeat("a", 64) + `"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "missing digest",
+			manifest: `{"fileName":"backup.2023-12-18T16:14:00Z.sql"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid digest",
+			manifest: `{"fileName":"backup.2023-12-18T16:14:00Z.sql","sha256":"foo"}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "manifest.json")
+			writeTestFile(t, path, tt.manifest)
+
+			_, err := ReadManifest(path)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIsValidManifestFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		want     bool
+	}{
+		{
+			name:     "manifest",
+			fileName: "backup.2023-12-18T16:14:00Z.sql.gz.manifest.json",
+			want:     true,
+		},
+		{
+			name:     "encrypted manifest",
+			fileName: "backup.2023-12-18T16:14:00Z.tar.enc.manifest.json",
+			want:     true,
+		},
+		{
+			name:     "backup file",
+			fileName: "backup.2023-12-18T16:14:00Z.sql.gz",
+			want:     false,
+		},
+		{
+			name:     "invalid backup file",
+			fileName: "foo.sql.manifest.json",
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidManifestFile(tt.fileName); got != tt.want {
+				t.Fatalf("unexpected result, expected: %v got: %v", tt.want, got)
+			}
+			if IsValidBackupFile(tt.fileName) && IsValidManifestFile(tt.fileName) {
+				t.Fatal("file cannot be both a backup file and a manifest")
+			}
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("unexpected error writing file: %v", err)
+	}
+}
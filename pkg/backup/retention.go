@@ -0,0 +1,134 @@
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// RetentionPolicy defines a grandfather-father-son retention policy. For every period, the most recent backup is kept
+// for the latest N periods that contain backups.
+type RetentionPolicy struct {
+	Hourly  int
+	Daily   int
+	Weekly  int
+	Monthly int
+	Yearly  int
+}
+
+// IsEnabled determines whether the policy keeps backups in any of the periods.
+func (r RetentionPolicy) IsEnabled() bool {
+	return r.Hourly > 0 || r.Daily > 0 || r.Weekly > 0 || r.Monthly > 0 || r.Yearly > 0
+}
+
+type retentionRule struct {
+	keep   int
+	period func(time.Time) string
+}
+
+func (r RetentionPolicy) rules() []retentionRule {
+	return []retentionRule{
+		{
+			keep: r.Hourly,
+			period: func(t time.Time) string {
+				return t.Format("2006-01-02T15")
+			},
+		},
+		{
+			keep: r.Daily,
+			period: func(t time.Time) string {
+				return t.Format("2006-01-02")
+			},
+		},
+		{
+			keep: r.Weekly,
+			period: func(t time.Time) string {
+				year, week := t.ISOWeek()
+				return fmt.Sprintf("%d-W%02d", year, week)
+			},
+		},
+		{
+			keep: r.Monthly,
+			period: func(t time.Time) string {
+				return t.Format("2006-01")
+			},
+		},
+		{
+			keep: r.Yearly,
+			period: func(t time.Time) string {
+				return t.Format("2006")
+			},
+		},
+	}
+}
+
+type backupDate struct {
+	fileName string
+	date     time.Time
+}
+
+// GetOldBackupFilesWithPolicy determines which backup files should be deleted according with the retention policy.
+// A backup file is retained if any of the periods of the policy keeps it. The policy is applied independently
+// to the backup files of every database.
+func GetOldBackupFilesWithPolicy(backupFileNames []string, policy RetentionPolicy, logger logr.Logger) []string {
+	var backupDates []backupDate
+	databaseBackupDates := make(map[string][]backupDate)
+	for _, file := range backupFileNames {
+		date, err := parseDateInBackupFile(file)
+		if err != nil {
+			logger.Error(err, "error parsing backup date. Skipping", "file", file)
+			continue
+		}
+		backup := backupDate{
+			fileName: file,
+			date:     date.UTC(),
+		}
+		backupDates = append(backupDates, backup)
+		database := ParseDatabaseInBackupFile(file)
+		databaseBackupDates[database] = append(databaseBackupDates[database], backup)
+	}
+
+	retained := make(map[string]bool)
+	for _, dates := range databaseBackupDates {
+		for _, file := range retainedBackupFiles(dates, policy) {
+			retained[file] = true
+		}
+	}
+
+	var oldBackups []string
+	for _, backup := range backupDates {
+		if !retained[backup.fileName] {
+			oldBackups = append(oldBackups, backup.fileName)
+		}
+	}
+	return oldBackups
+}
+
+func retainedBackupFiles(backupDates []backupDate, policy RetentionPolicy) []string {
+	sortedBackupDates := append([]backupDate{}, backupDates...)
+	sort.SliceStable(sortedBackupDates, func(i, j int) bool {
+		return sortedBackupDates[i].date.After(sortedBackupDates[j].date)
+	})
+
+	var retained []string
+	for _, rule := range policy.rules() {
+		if rule.keep <= 0 {
+			continue
+		}
+		periods := make(map[string]bool)
+		for _, backup := range sortedBackupDates {
+			if len(periods) >= rule.keep {
+				break
+			}
+			period := rule.period(backup.date)
+			if periods[period] {
+				continue
+			}
+			periods[period] = true
+			retained = append(retained, backup.fileName)
+		}
+	}
+	return retained
+}
@@ -0,0 +1,180 @@
+package backup
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRetentionPolicyIsEnabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      RetentionPolicy
+		wantEnabled bool
+	}{
+		{
+			name:        "empty",
+			policy:      RetentionPolicy{},
+			wantEnabled: false,
+		},
+		{
+			name: "daily",
+			policy: RetentionPolicy{
+				Daily: 7,
+			},
+			wantEnabled: true,
+		},
+		{
+			name: "yearly",
+			policy: RetentionPolicy{
+				Yearly: 1,
+			},
+			wantEnabled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled := tt.policy.IsEnabled()
+			if tt.wantEnabled != enabled {
+				t.Fatalf("unexpected enabled value, expected: %v got: %v", tt.wantEnabled, enabled)
+			}
+		})
+	}
+}
+
+func TestGetOldBackupFilesWithPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		backupFiles []string
+		policy      RetentionPolicy
+		wantBackups []string
+	}{
+		{
+			name:        "no backups",
+			backupFiles: nil,
+			policy: RetentionPolicy{
+				Hourly: 24,
+			},
+			wantBackups: nil,
+		},
+		{
+			name: "invalid backups",
+			backupFiles: []string{
+				"backup.foo.sql",
+				"backup.bar.sql",
+				"backup.sql",
+			},
+			policy: RetentionPolicy{
+				Hourly: 24,
+			},
+			wantBackups: nil,
+		},
+		{
+			name: "hourly",
+			backupFiles: []string{
+				"backup.2023-12-22T13:00:00Z.sql",
+				"backup.2023-12-22T13:30:00Z.sql",
+				"backup.2023-12-22T14:00:00Z.sql",
+				"backup.2023-12-22T14:30:00Z.sql",
+				"backup.2023-12-22T15:00:00Z.sql",
+				"backup.2023-12-22T15:30:00Z.sql",
+			},
+			policy: RetentionPolicy{
+				Hourly: 2,
+			},
+			wantBackups: []string{
+				"backup.2023-12-22T13:00:00Z.sql",
+				"backup.2023-12-22T13:30:00Z.sql",
+				"backup.2023-12-22T14:00:00Z.sql",
+				"backup.2023-12-22T15:00:00Z.sql",
+			},
+		},
+		{
+			name: "daily",
+			backupFiles: []string{
+				"backup.2023-12-20T10:00:00Z.sql",
+				"backup.2023-12-20T22:00:00Z.sql",
+				"backup.2023-12-21T10:00:00Z.sql",
+				"backup.2023-12-21T22:00:00Z.sql",
+				"backup.2023-12-22T10:00:00Z.sql",
+				"backup.2023-12-22T22:00:00Z.sql",
+			},
+			policy: RetentionPolicy{
+				Daily: 2,
+			},
+			wantBackups: []string{
+				"backup.2023-12-20T10:00:00Z.sql",
+				"backup.2023-12-20T22:00:00Z.sql",
+				"backup.2023-12-21T10:00:00Z.sql",
+				"backup.2023-12-22T10:00:00Z.sql",
+			},
+		},
+		{
+			name: "grandfather-father-son",
+			backupFiles: []string{
+				"backup.2022-12-31T00:00:00Z.sql",
+				"backup.2023-10-31T00:00:00Z.sql",
+				"backup.2023-11-30T00:00:00Z.sql",
+				"backup.2023-12-10T00:00:00Z.sql",
+				"backup.2023-12-17T00:00:00Z.sql",
+				"backup.2023-12-20T00:00:00Z.sql",
+				"backup.2023-12-21T00:00:00Z.sql",
+				"backup.2023-12-22T00:00:00Z.sql",
+				"backup.2023-12-22T12:00:00Z.sql",
+			},
+			policy: RetentionPolicy{
+				Hourly:  1,
+				Daily:   2,
+				Weekly:  2,
+				Monthly: 2,
+				Yearly:  2,
+			},
+			wantBackups: []string{
+				"backup.2023-10-31T00:00:00Z.sql",
+				"backup.2023-12-10T00:00:00Z.sql",
+				"backup.2023-12-20T00:00:00Z.sql",
+				"backup.2023-12-22T00:00:00Z.sql",
+			},
+		},
+		{
+			name: "compressed and encrypted backups",
+			backupFiles: []string{
+				"backup.2023-12-20T00:00:00Z.sql.gz",
+				"backup.2023-12-21T00:00:00Z.sql.gz.enc",
+				"backup.2023-12-22T00:00:00Z.sql.zst",
+				"backup.foo.sql",
+			},
+			policy: RetentionPolicy{
+				Daily: 2,
+			},
+			wantBackups: []string{
+				"backup.2023-12-20T00:00:00Z.sql.gz",
+			},
+		},
+		{
+			name: "per-database backups",
+			backupFiles: []string{
+				"backup.2023-12-20T00:00:00Z.app.sql",
+				"backup.2023-12-20T00:00:00Z.billing.sql",
+				"backup.2023-12-21T00:00:00Z.app.sql",
+				"backup.2023-12-21T00:00:00Z.billing.sql",
+				"backup.2023-12-22T00:00:00Z.app.sql",
+			},
+			policy: RetentionPolicy{
+				Daily: 2,
+			},
+			wantBackups: []string{
+				"backup.2023-12-20T00:00:00Z.app.sql",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backups := GetOldBackupFilesWithPolicy(tt.backupFiles, tt.policy, logger)
+			if !reflect.DeepEqual(tt.wantBackups, backups) {
+				t.Fatalf("unexpected backup files, expected: %v got: %v", tt.wantBackups, backups)
+			}
+		})
+	}
+}
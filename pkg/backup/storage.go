@@ -2,13 +2,22 @@ package backup
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/go-logr/logr"
 	mariadbminio "github.com/mariadb-operator/mariadb-operator/pkg/minio"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 )
 
 type BackupStorage interface {
@@ -18,16 +27,44 @@ type BackupStorage interface {
 	Delete(ctx context.Context, fileName string) error
 }
 
+// StreamingBackupStorage is a BackupStorage able to push and pull backup files as streams, without staging them locally.
+type StreamingBackupStorage interface {
+	BackupStorage
+	PushStream(ctx context.Context, fileName string, reader io.Reader) error
+	PullStream(ctx context.Context, fileName string) (io.ReadCloser, error)
+}
+
+// S3StreamPartSize is the size of the parts uploaded when streaming backups to S3, which are buffered in memory.
+// As multipart uploads are limited to 10000 parts, it allows streaming backups up to 625GiB.
+const S3StreamPartSize = 64 * 1024 * 1024
+
+// FileFilter determines whether a file should be processed by a BackupStorage.
+type FileFilter func(fileName string) bool
+
+type FileSystemBackupStorageOpt func(f *FileSystemBackupStorage)
+
+func WithFileSystemFileFilter(fileFilter FileFilter) FileSystemBackupStorageOpt {
+	return func(f *FileSystemBackupStorage) {
+		f.fileFilter = fileFilter
+	}
+}
+
 type FileSystemBackupStorage struct {
-	basePath string
-	logger   logr.Logger
+	basePath   string
+	fileFilter FileFilter
+	logger     logr.Logger
 }
 
-func NewFileSystemBackupStorage(basePath string, logger logr.Logger) BackupStorage {
-	return &FileSystemBackupStorage{
-		basePath: basePath,
-		logger:   logger,
+func NewFileSystemBackupStorage(basePath string, logger logr.Logger, opts ...FileSystemBackupStorageOpt) BackupStorage {
+	storage := &FileSystemBackupStorage{
+		basePath:   basePath,
+		fileFilter: IsValidBackupFile,
+		logger:     logger,
+	}
+	for _, setOpt := range opts {
+		setOpt(storage)
 	}
+	return storage
 }
 
 func (f *FileSystemBackupStorage) List(ctx context.Context) ([]string, error) {
@@ -38,7 +75,7 @@ func (f *FileSystemBackupStorage) List(ctx context.Context) ([]string, error) {
 	var fileNames []string
 	for _, e := range entries {
 		fileName := e.Name()
-		if shouldProcessBackupFile(fileName, f.logger) {
+		if shouldProcessBackupFile(fileName, f.fileFilter, f.logger) {
 			fileNames = append(fileNames, fileName)
 		}
 	}
@@ -57,11 +94,25 @@ func (f *FileSystemBackupStorage) Delete(ctx context.Context, fileName string) e
 	return os.Remove(filepath.Join(f.basePath, fileName))
 }
 
+// S3 server-side encryption types.
+const (
+	S3SSES3  = "SSE-S3"
+	S3SSEKMS = "SSE-KMS"
+	S3SSEC   = "SSE-C"
+)
+
 type S3BackupStorageOpts struct {
-	Region     string
-	Prefix     string
-	TLS        bool
-	CACertPath string
+	Region         string
+	Prefix         string
+	TLS            bool
+	CACertPath     string
+	IAM            bool
+	SSEType        string
+	SSEKMSKeyID    string
+	SSECustomerKey []byte
+	StorageClass   string
+	Tags           map[string]string
+	FileFilter     FileFilter
 }
 
 type S3BackupStorageOpt func(s *S3BackupStorageOpts)
@@ -78,6 +129,12 @@ func WithPrefix(prefix string) S3BackupStorageOpt {
 	}
 }
 
+func WithFileFilter(fileFilter FileFilter) S3BackupStorageOpt {
+	return func(s *S3BackupStorageOpts) {
+		s.FileFilter = fileFilter
+	}
+}
+
 func WithTLS(caCertPath string) S3BackupStorageOpt {
 	return func(s *S3BackupStorageOpts) {
 		s.TLS = true
@@ -85,16 +142,46 @@ func WithTLS(caCertPath string) S3BackupStorageOpt {
 	}
 }
 
+func WithIAM() S3BackupStorageOpt {
+	return func(s *S3BackupStorageOpts) {
+		s.IAM = true
+	}
+}
+
+// WithSSE enables server-side encryption. The KMS key ID is only used by SSE-KMS, and the customer key is only used by SSE-C.
+func WithSSE(sseType, kmsKeyID string, customerKey []byte) S3BackupStorageOpt {
+	return func(s *S3BackupStorageOpts) {
+		s.SSEType = sseType
+		s.SSEKMSKeyID = kmsKeyID
+		s.SSECustomerKey = customerKey
+	}
+}
+
+func WithStorageClass(storageClass string) S3BackupStorageOpt {
+	return func(s *S3BackupStorageOpts) {
+		s.StorageClass = storageClass
+	}
+}
+
+func WithTags(tags map[string]string) S3BackupStorageOpt {
+	return func(s *S3BackupStorageOpts) {
+		s.Tags = tags
+	}
+}
+
 type S3BackupStorage struct {
 	S3BackupStorageOpts
 	basePath string
 	bucket   string
 	logger   logr.Logger
 	client   *minio.Client
+	sse      encrypt.ServerSide
 }
 
 func NewS3BackupStorage(basePath, bucket, endpoint string, logger logr.Logger, s3Opts ...S3BackupStorageOpt) (BackupStorage, error) {
-	opts := S3BackupStorageOpts{}
+	opts := S3BackupStorageOpts{
+		FileFilter: IsValidBackupFile,
+	}
 	for _, setOpt := range s3Opts {
 		setOpt(&opts)
 	}
@@ -105,10 +192,17 @@ func NewS3BackupStorage(basePath, bucket, endpoint string, logger logr.Logger, s
 	if opts.TLS {
 		clientOpts = append(clientOpts, mariadbminio.WithTLS(opts.CACertPath))
 	}
+	if opts.IAM {
+		clientOpts = append(clientOpts, mariadbminio.WithIAM())
+	}
 	client, err := mariadbminio.NewMinioClient(endpoint, clientOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating S3 client: %v", err)
 	}
+	sse, err := newS3ServerSideEncryption(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring S3 server-side encryption: %v", err)
+	}
 
 	return &S3BackupStorage{
 		S3BackupStorageOpts: opts,
@@ -116,6 +210,7 @@ func NewS3BackupStorage(basePath, bucket, endpoint string, logger logr.Logger, s
 		bucket:              bucket,
 		client:              client,
 		logger:              logger,
+		sse:                 sse,
 	}, nil
 }
 
@@ -124,8 +219,8 @@ func (s *S3BackupStorage) List(ctx context.Context) ([]string, error) {
 	for o := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
 		Prefix: s.Prefix,
 	}) {
-		fileName := o.Key
-		if shouldProcessBackupFile(fileName, s.logger) {
+		fileName := strings.TrimPrefix(o.Key, s.Prefix)
+		if shouldProcessBackupFile(fileName, s.FileFilter, s.logger) {
 			fileNames = append(fileNames, fileName)
 		}
 	}
@@ -134,22 +229,332 @@ func (s *S3BackupStorage) List(ctx context.Context) ([]string, error) {
 
 func (s *S3BackupStorage) Push(ctx context.Context, fileName string) error {
 	filePath := filepath.Join(s.basePath, fileName)
-	_, err := s.client.FPutObject(ctx, s.bucket, s.Prefix+fileName, filePath, minio.PutObjectOptions{})
+	_, err := s.client.FPutObject(ctx, s.bucket, s.Prefix+fileName, filePath, s.putObjectOptions())
 	return err
 }
 
 func (s *S3BackupStorage) Pull(ctx context.Context, fileName string) error {
 	filePath := filepath.Join(s.basePath, fileName)
-	return s.client.FGetObject(ctx, s.bucket, s.Prefix+fileName, filePath, minio.GetObjectOptions{})
+	return s.client.FGetObject(ctx, s.bucket, s.Prefix+fileName, filePath, s.getObjectOptions())
+}
+
+// PushStream uploads the backup file as it is read from the stream using a multipart upload, which is aborted
+// if the stream returns an error.
+func (s *S3BackupStorage) PushStream(ctx context.Context, fileName string, reader io.Reader) error {
+	opts := s.putObjectOptions()
+	opts.PartSize = S3StreamPartSize
+	_, err := s.client.PutObject(ctx, s.bucket, s.Prefix+fileName, reader, -1, opts)
+	return err
+}
+
+func (s *S3BackupStorage) PullStream(ctx context.Context, fileName string) (io.ReadCloser, error) {
+	return s.client.GetObject(ctx, s.bucket, s.Prefix+fileName, s.getObjectOptions())
 }
 
 func (s *S3BackupStorage) Delete(ctx context.Context, fileName string) error {
 	return s.client.RemoveObject(ctx, s.bucket, s.Prefix+fileName, minio.RemoveObjectOptions{})
 }
 
-func shouldProcessBackupFile(fileName string, logger logr.Logger) bool {
+func (s *S3BackupStorage) putObjectOptions() minio.PutObjectOptions {
+	return minio.PutObjectOptions{
+		ServerSideEncryption: s.sse,
+		StorageClass:         s.StorageClass,
+		UserTags:             s.Tags,
+	}
+}
+
+// getObjectOptions only sends the SSE-C key when reading objects, as S3 rejects the rest of
+// server-side encryption headers in read requests.
+func (s *S3BackupStorage) getObjectOptions() minio.GetObjectOptions {
+	opts := minio.GetObjectOptions{}
+	if s.sse != nil && s.sse.Type() == encrypt.SSEC {
+		opts.ServerSideEncryption = s.sse
+	}
+	return opts
+}
+
+func newS3ServerSideEncryption(opts *S3BackupStorageOpts) (encrypt.ServerSide, error) {
+	switch opts.SSEType {
+	case "":
+		return nil, nil
+	case S3SSES3:
+		return encrypt.NewSSE(), nil
+	case S3SSEKMS:
+		return encrypt.NewSSEKMS(opts.SSEKMSKeyID, nil)
+	case S3SSEC:
+		return encrypt.NewSSEC(opts.SSECustomerKey)
+	default:
+		return nil, fmt.Errorf("unsupported server-side encryption type: %s", opts.SSEType)
+	}
+}
+
+type AzureBlobBackupStorageOpts struct {
+	ServiceURL string
+	Prefix     string
+	AccountKey string
+	SASToken   string
+	FileFilter FileFilter
+}
+
+type AzureBlobBackupStorageOpt func(a *AzureBlobBackupStorageOpts)
+
+func WithAzureBlobServiceURL(serviceURL string) AzureBlobBackupStorageOpt {
+	return func(a *AzureBlobBackupStorageOpts) {
+		a.ServiceURL = serviceURL
+	}
+}
+
+func WithAzureBlobPrefix(prefix string) AzureBlobBackupStorageOpt {
+	return func(a *AzureBlobBackupStorageOpts) {
+		a.Prefix = prefix
+	}
+}
+
+func WithAzureBlobAccountKey(accountKey string) AzureBlobBackupStorageOpt {
+	return func(a *AzureBlobBackupStorageOpts) {
+		a.AccountKey = accountKey
+	}
+}
+
+func WithAzureBlobSASToken(sasToken string) AzureBlobBackupStorageOpt {
+	return func(a *AzureBlobBackupStorageOpts) {
+		a.SASToken = sasToken
+	}
+}
+
+func WithAzureBlobFileFilter(fileFilter FileFilter) AzureBlobBackupStorageOpt {
+	return func(a *AzureBlobBackupStorageOpts) {
+		a.FileFilter = fileFilter
+	}
+}
+
+type AzureBlobBackupStorage struct {
+	AzureBlobBackupStorageOpts
+	basePath  string
+	container string
+	logger    logr.Logger
+	client    *azblob.Client
+}
+
+func NewAzureBlobBackupStorage(basePath, container, storageAccount string, logger logr.Logger,
+	azureBlobOpts ...AzureBlobBackupStorageOpt) (BackupStorage, error) {
+	opts := AzureBlobBackupStorageOpts{
+		ServiceURL: fmt.Sprintf("https://%s.blob.core.windows.net/", storageAccount),
+		FileFilter: IsValidBackupFile,
+	}
+	for _, setOpt := range azureBlobOpts {
+		setOpt(&opts)
+	}
+
+	client, err := newAzureBlobClient(storageAccount, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Azure Blob client: %v", err)
+	}
+
+	return &AzureBlobBackupStorage{
+		AzureBlobBackupStorageOpts: opts,
+		basePath:                   basePath,
+		container:                  container,
+		client:                     client,
+		logger:                     logger,
+	}, nil
+}
+
+func (a *AzureBlobBackupStorage) List(ctx context.Context) ([]string, error) {
+	var fileNames []string
+	pager := a.client.NewListBlobsFlatPager(a.container, &azblob.ListBlobsFlatOptions{
+		Prefix: &a.Prefix,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing blobs: %v", err)
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			fileName := strings.TrimPrefix(*item.Name, a.Prefix)
+			if shouldProcessBackupFile(fileName, a.FileFilter, a.logger) {
+				fileNames = append(fileNames, fileName)
+			}
+		}
+	}
+	return fileNames, nil
+}
+
+func (a *AzureBlobBackupStorage) Push(ctx context.Context, fileName string) error {
+	file, err := os.Open(filepath.Join(a.basePath, fileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = a.client.UploadFile(ctx, a.container, a.Prefix+fileName, file, nil)
+	return err
+}
+
+func (a *AzureBlobBackupStorage) Pull(ctx context.Context, fileName string) error {
+	file, err := os.Create(filepath.Join(a.basePath, fileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = a.client.DownloadFile(ctx, a.container, a.Prefix+fileName, file, nil)
+	return err
+}
+
+func (a *AzureBlobBackupStorage) Delete(ctx context.Context, fileName string) error {
+	_, err := a.client.DeleteBlob(ctx, a.container, a.Prefix+fileName, nil)
+	return err
+}
+
+func newAzureBlobClient(storageAccount string, opts *AzureBlobBackupStorageOpts) (*azblob.Client, error) {
+	if opts.AccountKey != "" {
+		credential, err := azblob.NewSharedKeyCredential(storageAccount, opts.AccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("error creating shared key credential: %v", err)
+		}
+		return azblob.NewClientWithSharedKeyCredential(opts.ServiceURL, credential, nil)
+	}
+	if opts.SASToken != "" {
+		serviceURL, err := url.Parse(opts.ServiceURL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing service URL: %v", err)
+		}
+		serviceURL.RawQuery = strings.TrimPrefix(opts.SASToken, "?")
+		return azblob.NewClientWithNoCredential(serviceURL.String(), nil)
+	}
+	return nil, errors.New("either account key or SAS token must be provided")
+}
+
+type GCSBackupStorageOpts struct {
+	Prefix          string
+	CredentialsPath string
+	FileFilter      FileFilter
+}
+
+type GCSBackupStorageOpt func(g *GCSBackupStorageOpts)
+
+func WithGCSPrefix(prefix string) GCSBackupStorageOpt {
+	return func(g *GCSBackupStorageOpts) {
+		g.Prefix = prefix
+	}
+}
+
+func WithGCSCredentialsPath(credentialsPath string) GCSBackupStorageOpt {
+	return func(g *GCSBackupStorageOpts) {
+		g.CredentialsPath = credentialsPath
+	}
+}
+
+func WithGCSFileFilter(fileFilter FileFilter) GCSBackupStorageOpt {
+	return func(g *GCSBackupStorageOpts) {
+		g.FileFilter = fileFilter
+	}
+}
+
+type GCSBackupStorage struct {
+	GCSBackupStorageOpts
+	basePath string
+	bucket   string
+	logger   logr.Logger
+	client   *storage.Client
+}
+
+// NewGCSBackupStorage creates a BackupStorage backed by Google Cloud Storage. When no credentials path is provided,
+// the Application Default Credentials are used. The STORAGE_EMULATOR_HOST environment variable is honoured by the client,
+// which allows using emulators such as fake-gcs-server.
+func NewGCSBackupStorage(basePath, bucket string, logger logr.Logger, gcsOpts ...GCSBackupStorageOpt) (BackupStorage, error) {
+	opts := GCSBackupStorageOpts{
+		FileFilter: IsValidBackupFile,
+	}
+	for _, setOpt := range gcsOpts {
+		setOpt(&opts)
+	}
+
+	var clientOpts []option.ClientOption
+	if opts.CredentialsPath != "" {
+		clientOpts = append(clientOpts, option.WithCredentialsFile(opts.CredentialsPath))
+	}
+	client, err := storage.NewClient(context.Background(), clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GCS client: %v", err)
+	}
+
+	return &GCSBackupStorage{
+		GCSBackupStorageOpts: opts,
+		basePath:             basePath,
+		bucket:               bucket,
+		client:               client,
+		logger:               logger,
+	}, nil
+}
+
+func (g *GCSBackupStorage) List(ctx context.Context) ([]string, error) {
+	var fileNames []string
+	it := g.client.Bucket(g.bucket).Objects(ctx, &storage.Query{
+		Prefix: g.Prefix,
+	})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error listing objects: %v", err)
+		}
+		fileName := strings.TrimPrefix(attrs.Name, g.Prefix)
+		if shouldProcessBackupFile(fileName, g.FileFilter, g.logger) {
+			fileNames = append(fileNames, fileName)
+		}
+	}
+	return fileNames, nil
+}
+
+func (g *GCSBackupStorage) Push(ctx context.Context, fileName string) error {
+	file, err := os.Open(filepath.Join(g.basePath, fileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// cancelling the context aborts the upload without creating the object
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := g.client.Bucket(g.bucket).Object(g.Prefix + fileName).NewWriter(ctx)
+	if _, err := io.Copy(writer, file); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+func (g *GCSBackupStorage) Pull(ctx context.Context, fileName string) error {
+	reader, err := g.client.Bucket(g.bucket).Object(g.Prefix + fileName).NewReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	file, err := os.Create(filepath.Join(g.basePath, fileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, reader); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+func (g *GCSBackupStorage) Delete(ctx context.Context, fileName string) error {
+	return g.client.Bucket(g.bucket).Object(g.Prefix + fileName).Delete(ctx)
+}
+
+func shouldProcessBackupFile(fileName string, fileFilter FileFilter, logger logr.Logger) bool {
 	logger.V(1).Info("processing backup file", "file", fileName)
-	if IsValidBackupFile(fileName) {
+	if fileFilter(fileName) {
 		return true
 	}
 	logger.V(1).Info("ignoring file", "file", fileName)
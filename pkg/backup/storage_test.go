@@ -0,0 +1,320 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/fsouza/fake-gcs-server/fakestorage"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// Well-known credentials of the Azurite emulator.
+const (
+	azuriteStorageAccount = "devstoreaccount1"
+	azuriteAccountKey     = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+// TestAzureBlobBackupStorage runs against the Azurite emulator, for example:
+// docker run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0
+// AZURITE_SERVICE_URL=http://127.0.0.1:10000/devstoreaccount1 go test ./pkg/backup -run TestAzureBlobBackupStorage
+func TestAzureBlobBackupStorage(t *testing.T) {
+	serviceURL := os.Getenv("AZURITE_SERVICE_URL")
+	if serviceURL == "" {
+		t.Skip("AZURITE_SERVICE_URL not set, skipping Azure Blob storage test")
+	}
+	ctx := context.Background()
+	container := "backups"
+	prefix := "mariadb/"
+
+	credential, err := azblob.NewSharedKeyCredential(azuriteStorageAccount, azuriteAccountKey)
+	if err != nil {
+		t.Fatalf("unexpected error creating credential: %v", err)
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, credential, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %v", err)
+	}
+	if _, err := client.CreateContainer(ctx, container, nil); err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		t.Fatalf("unexpected error creating container: %v", err)
+	}
+
+	newStorage := func(basePath string) BackupStorage {
+		storage, err := NewAzureBlobBackupStorage(
+			basePath,
+			container,
+			azuriteStorageAccount,
+			logger,
+			WithAzureBlobServiceURL(serviceURL),
+			WithAzureBlobPrefix(prefix),
+			WithAzureBlobAccountKey(azuriteAccountKey),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error creating storage: %v", err)
+		}
+		return storage
+	}
+	testBackupStorage(ctx, t, newStorage)
+}
+
+func TestGCSBackupStorage(t *testing.T) {
+	ctx := context.Background()
+	bucket := "backups"
+	prefix := "mariadb/"
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error getting a free port: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	if err := listener.Close(); err != nil {
+		t.Fatalf("unexpected error closing listener: %v", err)
+	}
+	host := fmt.Sprintf("127.0.0.1:%d", port)
+
+	// Object reads use the XML API, which is only served for requests matching the public host.
+	server, err := fakestorage.NewServerWithOptions(fakestorage.Options{
+		Scheme:     "http",
+		Host:       "127.0.0.1",
+		Port:       uint16(port),
+		PublicHost: host,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error starting fake-gcs-server: %v", err)
+	}
+	defer server.Stop()
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: bucket})
+	t.Setenv("STORAGE_EMULATOR_HOST", host)
+
+	newStorage := func(basePath string) BackupStorage {
+		storage, err := NewGCSBackupStorage(basePath, bucket, logger, WithGCSPrefix(prefix))
+		if err != nil {
+			t.Fatalf("unexpected error creating storage: %v", err)
+		}
+		return storage
+	}
+	testBackupStorage(ctx, t, newStorage)
+}
+
+func TestS3ObjectOptions(t *testing.T) {
+	customerKey := []byte("0123456789abcdef0123456789abcdef")
+	tags := map[string]string{"team": "dba"}
+	tests := []struct {
+		name        string
+		opts        []S3BackupStorageOpt
+		wantPutSSE  encrypt.Type
+		wantGetSSE  bool
+		wantErr     bool
+		wantClass   string
+		wantTags    map[string]string
+		wantHeaders map[string]string
+	}{
+		{
+			name: "no encryption",
+		},
+		{
+			name: "SSE-S3 with storage class and tags",
+			opts: []S3BackupStorageOpt{
+				WithSSE(S3SSES3, "", nil),
+				WithStorageClass("STANDARD_IA"),
+				WithTags(tags),
+			},
+			wantPutSSE: encrypt.S3,
+			wantClass:  "STANDARD_IA",
+			wantTags:   tags,
+		},
+		{
+			name: "SSE-KMS",
+			opts: []S3BackupStorageOpt{
+				WithSSE(S3SSEKMS, "key-id", nil),
+			},
+			wantPutSSE: encrypt.KMS,
+			wantHeaders: map[string]string{
+				encrypt.SseKmsKeyID: "key-id",
+			},
+		},
+		{
+			name: "SSE-C",
+			opts: []S3BackupStorageOpt{
+				WithSSE(S3SSEC, "", customerKey),
+			},
+			wantPutSSE: encrypt.SSEC,
+			wantGetSSE: true,
+		},
+		{
+			name: "SSE-C with invalid key",
+			opts: []S3BackupStorageOpt{
+				WithSSE(S3SSEC, "", []byte("foo")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported encryption",
+			opts: []S3BackupStorageOpt{
+				WithSSE("foo", "", nil),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, err := NewS3BackupStorage(t.TempDir(), "backups", "127.0.0.1:9000", logger, tt.opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error creating storage: %v", err)
+			}
+			s3Storage := storage.(*S3BackupStorage)
+
+			putOpts := s3Storage.putObjectOptions()
+			if tt.wantPutSSE == "" && putOpts.ServerSideEncryption != nil {
+				t.Fatalf("unexpected server-side encryption: %v", putOpts.ServerSideEncryption.Type())
+			}
+			if tt.wantPutSSE != "" && (putOpts.ServerSideEncryption == nil || putOpts.ServerSideEncryption.Type() != tt.wantPutSSE) {
+				t.Fatalf("unexpected server-side encryption, expected: %v got: %v", tt.wantPutSSE, putOpts.ServerSideEncryption)
+			}
+			if putOpts.StorageClass != tt.wantClass {
+				t.Fatalf("unexpected storage class, expected: %v got: %v", tt.wantClass, putOpts.StorageClass)
+			}
+			if !reflect.DeepEqual(putOpts.UserTags, tt.wantTags) {
+				t.Fatalf("unexpected tags, expected: %v got: %v", tt.wantTags, putOpts.UserTags)
+			}
+			if len(tt.wantHeaders) > 0 {
+				header := http.Header{}
+				putOpts.ServerSideEncryption.Marshal(header)
+				for k, v := range tt.wantHeaders {
+					if got := header.Get(k); got != v {
+						t.Fatalf("unexpected header %s, expected: %v got: %v", k, v, got)
+					}
+				}
+			}
+
+			getOpts := s3Storage.getObjectOptions()
+			if tt.wantGetSSE != (getOpts.ServerSideEncryption != nil) {
+				t.Fatalf("unexpected server-side encryption when reading objects: %v", getOpts.ServerSideEncryption)
+			}
+		})
+	}
+}
+
+// testBackupStorage pushes, lists, pulls and deletes backup files using the BackupStorage returned by newStorage.
+func testBackupStorage(ctx context.Context, t *testing.T, newStorage func(basePath string) BackupStorage) {
+	pushPath := t.TempDir()
+	storage := newStorage(pushPath)
+
+	backupFile := "backup.2023-12-18T16:14:00Z.sql"
+	backupData := []byte("CREATE DATABASE test;")
+	if err := os.WriteFile(filepath.Join(pushPath, backupFile), backupData, 0644); err != nil {
+		t.Fatalf("unexpected error writing backup file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(pushPath, "foo.txt"), []byte("foo"), 0644); err != nil {
+		t.Fatalf("unexpected error writing file: %v", err)
+	}
+	if err := storage.Push(ctx, backupFile); err != nil {
+		t.Fatalf("unexpected error pushing backup file: %v", err)
+	}
+	if err := storage.Push(ctx, "foo.txt"); err != nil {
+		t.Fatalf("unexpected error pushing file: %v", err)
+	}
+
+	files, err := storage.List(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error listing backup files: %v", err)
+	}
+	if !reflect.DeepEqual([]string{backupFile}, files) {
+		t.Fatalf("unexpected backup files, expected: %v got: %v", []string{backupFile}, files)
+	}
+
+	pullPath := t.TempDir()
+	if err := newStorage(pullPath).Pull(ctx, backupFile); err != nil {
+		t.Fatalf("unexpected error pulling backup file: %v", err)
+	}
+	bytes, err := os.ReadFile(filepath.Join(pullPath, backupFile))
+	if err != nil {
+		t.Fatalf("unexpected error reading backup file: %v", err)
+	}
+	if string(backupData) != string(bytes) {
+		t.Fatalf("unexpected backup data, expected: %s got: %s", backupData, bytes)
+	}
+
+	for _, file := range []string{backupFile, "foo.txt"} {
+		if err := storage.Delete(ctx, file); err != nil {
+			t.Fatalf("unexpected error deleting file: %v", err)
+		}
+	}
+	files, err = storage.List(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error listing backup files: %v", err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("expected no backup files, got: %v", files)
+	}
+}
+
+func TestS3BackupStorageList(t *testing.T) {
+	bucket := "backups"
+	prefix := "mariadb/"
+	keys := []string{
+		prefix + "backup.2023-12-18T16:14:00Z.sql",
+		prefix + "backup.2023-12-19T16:14:00Z.sql",
+		prefix + "foo.txt",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+bucket+"/" && r.URL.Path != "/"+bucket {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("prefix"); got != prefix {
+			t.Errorf("unexpected list prefix, expected: %s got: %s", prefix, got)
+		}
+		var contents strings.Builder
+		for _, key := range keys {
+			fmt.Fprintf(&contents, "<Contents><Key>%s</Key><Size>1</Size></Contents>", key)
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprintf(w,
+			`<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>%s</Name><Prefix>%s</Prefix>`+
+				`<KeyCount>%d</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>%s</ListBucketResult>`,
+			bucket, prefix, len(keys), contents.String())
+	}))
+	defer server.Close()
+
+	storage, err := NewS3BackupStorage(
+		t.TempDir(),
+		bucket,
+		strings.TrimPrefix(server.URL, "http://"),
+		logger,
+		WithRegion("us-east-1"),
+		WithPrefix(prefix),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating storage: %v", err)
+	}
+
+	fileNames, err := storage.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error listing files: %v", err)
+	}
+	wantFileNames := []string{
+		"backup.2023-12-18T16:14:00Z.sql",
+		"backup.2023-12-19T16:14:00Z.sql",
+	}
+	if !reflect.DeepEqual(wantFileNames, fileNames) {
+		t.Fatalf("unexpected files, expected: %v got: %v", wantFileNames, fileNames)
+	}
+}
@@ -0,0 +1,79 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+)
+
+const (
+	// StreamFile is the named pipe used to stream the backup between the containers of the Job.
+	StreamFile = "0-backup-stream"
+	// StreamCompleteFile is created by the writer of the stream once the backup has been completely written.
+	StreamCompleteFile = "0-backup-stream-complete"
+)
+
+// CreateStream creates a named pipe in the given path, replacing any stream left by previous attempts.
+func CreateStream(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing previous stream: %v", err)
+	}
+	if err := syscall.Mkfifo(path, 0666); err != nil {
+		return fmt.Errorf("error creating stream: %v", err)
+	}
+	return nil
+}
+
+// WaitForFile waits until the file exists in the given path.
+func WaitForFile(ctx context.Context, path string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for {
+		if _, err := os.Stat(path); err == nil {
+			return nil
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for file %s: %v", path, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// completeStreamReader reads a stream, which only reaches its end once the writer has created the completion file.
+// Otherwise, an error is returned instead of io.EOF, so partially written backups are not taken as complete.
+type completeStreamReader struct {
+	io.Reader
+	ctx              context.Context
+	completeFilePath string
+	timeout          time.Duration
+}
+
+// NewCompleteStreamReader returns a reader that waits for the completion file when reaching the end of the stream.
+func NewCompleteStreamReader(ctx context.Context, reader io.Reader, completeFilePath string, timeout time.Duration) io.Reader {
+	return &completeStreamReader{
+		Reader:           reader,
+		ctx:              ctx,
+		completeFilePath: completeFilePath,
+		timeout:          timeout,
+	}
+}
+
+func (c *completeStreamReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	if err != io.EOF {
+		return n, err
+	}
+	if waitErr := WaitForFile(c.ctx, c.completeFilePath, c.timeout); waitErr != nil {
+		return n, fmt.Errorf("stream ended before being completed: %v", waitErr)
+	}
+	return n, err
+}
@@ -0,0 +1,71 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompleteStreamReader(t *testing.T) {
+	tests := []struct {
+		name     string
+		complete bool
+		wantErr  bool
+	}{
+		{
+			name:     "complete stream",
+			complete: true,
+			wantErr:  false,
+		},
+		{
+			name:     "incomplete stream",
+			complete: false,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completeFilePath := filepath.Join(t.TempDir(), StreamCompleteFile)
+			if tt.complete {
+				writeTestFile(t, completeFilePath, "")
+			}
+			reader := NewCompleteStreamReader(context.Background(), strings.NewReader("CREATE DATABASE test;"),
+				completeFilePath, 100*time.Millisecond)
+
+			bytes, err := io.ReadAll(reader)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(bytes) != "CREATE DATABASE test;" {
+				t.Fatalf("unexpected stream data: %s", bytes)
+			}
+		})
+	}
+}
+
+func TestCreateStream(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StreamFile)
+	writeTestFile(t, path, "stale")
+
+	if err := CreateStream(path); err != nil {
+		t.Fatalf("unexpected error creating stream: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unexpected error getting stream info: %v", err)
+	}
+	if info.Mode()&os.ModeNamedPipe == 0 {
+		t.Fatalf("expected named pipe, got mode: %v", info.Mode())
+	}
+}
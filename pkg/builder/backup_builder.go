@@ -30,6 +30,7 @@ func (b *Builder) BuildRestore(mariadb *mariadbv1alpha1.MariaDB, key types.Names
 			Tolerations:        mariadb.Spec.Tolerations,
 			PodSecurityContext: mariadb.Spec.PodSecurityContext,
 			SecurityContext:    mariadb.Spec.ContainerTemplate.SecurityContext,
+			ServiceAccountName: mariadb.Spec.ServiceAccountName,
 		},
 	}
 	if err := controllerutil.SetControllerReference(mariadb, restore, b.scheme); err != nil {
@@ -4,34 +4,66 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	metadata "github.com/mariadb-operator/mariadb-operator/pkg/builder/metadata"
 	"github.com/mariadb-operator/mariadb-operator/pkg/command"
+	annotation "github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	"github.com/mariadb-operator/mariadb-operator/pkg/statefulset"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 const (
-	batchStorageVolume     = "backup"
-	batchStorageMountPath  = "/backup"
-	batchScriptsVolume     = "scripts"
-	batchS3PKI             = "s3-pki"
-	batchS3PKIMountPath    = "/s3/pki"
-	batchScriptsMountPath  = "/opt"
-	batchScriptsSqlFile    = "job.sql"
-	batchUserEnv           = "MARIADB_OPERATOR_USER"
-	batchPasswordEnv       = "MARIADB_OPERATOR_PASSWORD"
-	batchS3AccessKeyId     = "AWS_ACCESS_KEY_ID"
-	batchS3SecretAccessKey = "AWS_SECRET_ACCESS_KEY"
-	batchS3SessionTokenKey = "AWS_SESSION_TOKEN"
+	batchStorageVolume       = "backup"
+	batchStorageMountPath    = "/backup"
+	batchScriptsVolume       = "scripts"
+	batchS3PKI               = "s3-pki"
+	batchS3PKIMountPath      = "/s3/pki"
+	batchS3SSE               = "s3-sse"
+	batchS3SSEMountPath      = "/s3/sse"
+	batchScriptsMountPath    = "/opt"
+	batchScriptsSqlFile      = "job.sql"
+	batchUserEnv             = "MARIADB_OPERATOR_USER"
+	batchPasswordEnv         = "MARIADB_OPERATOR_PASSWORD"
+	batchS3AccessKeyId       = "AWS_ACCESS_KEY_ID"
+	batchS3SecretAccessKey   = "AWS_SECRET_ACCESS_KEY"
+	batchS3SessionTokenKey   = "AWS_SESSION_TOKEN"
+	batchDataVolume          = "data"
+	batchStagingVolume       = "staging"
+	batchStagingMountPath    = "/staging"
+	batchEncryptionVolume    = "encryption"
+	batchEncryptionMountPath = "/encryption"
+	batchDecryptVolume       = "decrypt"
+	batchDecryptMountPath    = "/decrypt"
+	batchAzureBlobAccountKey = "AZURE_STORAGE_ACCOUNT_KEY"
+	batchAzureBlobSASToken   = "AZURE_STORAGE_SAS_TOKEN"
+
+	batchGCSCredentials          = "gcs-credentials"
+	batchGCSCredentialsMountPath = "/gcs"
+
+	batchVerifyVolume      = "verify"
+	batchVerifyMountPath   = "/verify"
+	batchVerifySqlQueryEnv = "MARIADB_OPERATOR_VERIFY_QUERY"
 )
 
-var batchBackupTargetFilePath = fmt.Sprintf("%s/0-backup-target.txt", batchStorageMountPath)
+var (
+	batchBackupTargetFilePath = fmt.Sprintf("%s/0-backup-target.txt", batchStorageMountPath)
+	batchBinlogTargetFilePath = fmt.Sprintf("%s/0-binlog-archived.txt", batchStorageMountPath)
+	batchVerifyTargetFilePath = fmt.Sprintf("%s/0-backup-target.txt", batchVerifyMountPath)
+	batchVerifyDatadirPath    = fmt.Sprintf("%s/data", batchVerifyMountPath)
+)
 
+// BuildBackupJob builds a Job that takes a Backup. The Backup is taken from the Pod with the given index when provided,
+// otherwise the primary is used.
 func (b *Builder) BuildBackupJob(key types.NamespacedName, backup *mariadbv1alpha1.Backup,
-	mariadb *mariadbv1alpha1.MariaDB) (*batchv1.Job, error) {
+	mariadb *mariadbv1alpha1.MariaDB, podIndex *int) (*batchv1.Job, error) {
 	objMeta :=
 		metadata.NewMetadataBuilder(key).
 			WithMariaDB(mariadb).
@@ -43,12 +75,34 @@ func (b *Builder) BuildBackupJob(key types.NamespacedName, backup *mariadbv1alph
 			batchBackupTargetFilePath,
 		),
 		command.WithBackupMaxRetention(backup.Spec.MaxRetention.Duration),
+		command.WithBackupRetentionPolicy(backup.Spec.RetentionPolicy),
+		command.WithBackupCatalog(corev1.TerminationMessagePathDefault, backup.CatalogLimitOrDefault()),
 		command.WithBackupUserEnv(batchUserEnv),
 		command.WithBackupPasswordEnv(batchPasswordEnv),
 		command.WithBackupLogLevel(backup.Spec.LogLevel),
 		command.WithBackupDumpOpts(backup.Spec.Args),
+		command.WithBackupCompression(backup.Spec.Compression),
+		command.WithBackupDatabases(backup.Spec.Databases, backup.Spec.Tables),
+	}
+	cmdOpts = append(cmdOpts, storageOpts(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob, backup.Spec.Storage.GCS)...)
+	if backup.IsPhysical() {
+		if mariadb.IsEphemeralStorageEnabled() {
+			return nil, errors.New("physical backups are not supported with ephemeral storage")
+		}
+		cmdOpts = append(cmdOpts, command.WithBackupPhysical(MariadbStorageMountPath, batchStagingMountPath))
+	}
+	if backup.IsEncrypted() {
+		cmdOpts = append(cmdOpts, command.WithBackupEncryption(encryptionKeyPath(backup.Spec.Encryption)))
+	}
+	if podIndex != nil {
+		cmdOpts = append(cmdOpts, command.WithBackupTargetPodIndex(*podIndex))
+	}
+	if backup.IsMydumperEngine() {
+		cmdOpts = append(cmdOpts, command.WithBackupMydumper(backup.Spec.Mydumper))
+	}
+	if backup.IsStreaming() {
+		cmdOpts = append(cmdOpts, command.WithS3Streaming())
 	}
-	cmdOpts = append(cmdOpts, s3Opts(backup.Spec.Storage.S3)...)
 
 	cmd, err := command.NewBackupCommand(cmdOpts...)
 	if err != nil {
@@ -59,39 +113,92 @@ func (b *Builder) BuildBackupJob(key types.NamespacedName, backup *mariadbv1alph
 	if err != nil {
 		return nil, fmt.Errorf("error getting volume from Backup: %v", err)
 	}
-	volumes, volumeSources := jobBatchStorageVolume(volume, backup.Spec.Storage.S3)
+	volumes, volumeSources := jobBatchStorageVolume(volume, backup.Spec.Storage.S3, backup.Spec.Storage.GCS)
+
+	operatorVolumeSources := volumeSources
+	if backup.IsEncrypted() {
+		encryptionVolumes, encryptionVolumeMounts := jobEncryptionVolumes(backup.Spec.Encryption)
+		volumes = append(volumes, encryptionVolumes...)
+		operatorVolumeSources = append(jobCloneVolumeMounts(volumeSources), encryptionVolumeMounts...)
+	}
+
+	backupVolumes := volumes
+	backupVolumeSources := volumeSources
+	affinity := backup.Spec.Affinity
+	backupContainer := jobMariadbContainer(
+		cmd.MariadbDump(backup, mariadb),
+		backupVolumeSources,
+		jobEnv(mariadb),
+		backup.Spec.Resources,
+		mariadb,
+		backup.Spec.SecurityContext,
+	)
+	if backup.IsMydumperEngine() {
+		backupContainer = jobMydumperContainer(
+			cmd.Mydumper(mariadb),
+			backupVolumeSources,
+			jobEnv(mariadb),
+			backup.Spec.Resources,
+			mariadb,
+			b.env,
+			backup.Spec.SecurityContext,
+		)
+	}
+	if backup.IsPhysical() {
+		physicalPodIndex := ptr.Deref(mariadb.Status.CurrentPrimaryPodIndex, 0)
+		if podIndex != nil {
+			physicalPodIndex = *podIndex
+		}
+		dataVolumes, dataVolumeMounts := jobPhysicalVolumes(mariadb.StoragePVCKey(physicalPodIndex).Name, true)
+		backupVolumes = append(backupVolumes, dataVolumes...)
+		backupVolumeSources = append(backupVolumeSources, dataVolumeMounts...)
+		affinity = jobPodAffinity(affinity, statefulset.PodName(mariadb.ObjectMeta, physicalPodIndex))
+
+		backupContainer = jobMariadbContainer(
+			cmd.MariaBackup(mariadb, physicalPodIndex),
+			backupVolumeSources,
+			jobEnv(mariadb),
+			backup.Spec.Resources,
+			mariadb,
+			backup.Spec.SecurityContext,
+		)
+	}
+
+	operatorContainer := jobMariadbOperatorContainer(
+		cmd.MariadbOperatorBackup(),
+		operatorVolumeSources,
+		jobStorageEnv(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob),
+		backup.Spec.Resources,
+		mariadb,
+		b.env,
+		backup.Spec.SecurityContext,
+	)
+	// When streaming, the backup is uploaded while it is being taken, therefore both containers run concurrently.
+	containerOpts := []jobOption{
+		withJobInitContainers(backupContainer),
+		withJobContainers(operatorContainer),
+	}
+	restartPolicy := backup.Spec.RestartPolicy
+	if backup.IsStreaming() {
+		containerOpts = []jobOption{
+			withJobContainers(backupContainer, operatorContainer),
+		}
+		// Restarting a single container would leave it waiting on a stream that the other one has already abandoned.
+		restartPolicy = corev1.RestartPolicyNever
+	}
 
 	opts := []jobOption{
 		withJobMeta(objMeta),
-		withJobVolumes(volumes...),
-		withJobInitContainers(
-			jobMariadbContainer(
-				cmd.MariadbDump(backup, mariadb),
-				volumeSources,
-				jobEnv(mariadb),
-				backup.Spec.Resources,
-				mariadb,
-				backup.Spec.SecurityContext,
-			),
-		),
-		withJobContainers(
-			jobMariadbOperatorContainer(
-				cmd.MariadbOperatorBackup(),
-				volumeSources,
-				jobS3Env(backup.Spec.Storage.S3),
-				backup.Spec.Resources,
-				mariadb,
-				b.env,
-				backup.Spec.SecurityContext,
-			),
-		),
+		withJobVolumes(backupVolumes...),
 		withJobBackoffLimit(backup.Spec.BackoffLimit),
-		withJobRestartPolicy(backup.Spec.RestartPolicy),
-		withAffinity(backup.Spec.Affinity),
+		withJobRestartPolicy(restartPolicy),
+		withAffinity(affinity),
 		withNodeSelector(backup.Spec.NodeSelector),
 		withTolerations(backup.Spec.Tolerations...),
 		withPodSecurityContext(backup.Spec.PodSecurityContext),
+		withServiceAccountName(backup.Spec.ServiceAccountName),
 	}
+	opts = append(opts, containerOpts...)
 
 	builder, err := newJobBuilder(opts...)
 	if err != nil {
@@ -106,7 +213,7 @@ func (b *Builder) BuildBackupJob(key types.NamespacedName, backup *mariadbv1alph
 }
 
 func (b *Builder) BuildBackupCronJob(key types.NamespacedName, backup *mariadbv1alpha1.Backup,
-	mariadb *mariadbv1alpha1.MariaDB) (*batchv1.CronJob, error) {
+	mariadb *mariadbv1alpha1.MariaDB, podIndex *int) (*batchv1.CronJob, error) {
 	if backup.Spec.Schedule == nil {
 		return nil, errors.New("schedule field is mandatory when building a CronJob")
 	}
@@ -115,7 +222,7 @@ func (b *Builder) BuildBackupCronJob(key types.NamespacedName, backup *mariadbv1
 		metadata.NewMetadataBuilder(key).
 			WithMariaDB(mariadb).
 			Build()
-	job, err := b.BuildBackupJob(key, backup, mariadb)
+	job, err := b.BuildBackupJob(key, backup, mariadb, podIndex)
 	if err != nil {
 		return nil, fmt.Errorf("error building Backup: %v", err)
 	}
@@ -138,30 +245,188 @@ func (b *Builder) BuildBackupCronJob(key types.NamespacedName, backup *mariadbv1
 	return cronJob, nil
 }
 
-func (b *Builder) BuildRestoreJob(key types.NamespacedName, restore *mariadbv1alpha1.Restore,
+func (b *Builder) BuildBinlogArchiveCronJob(key types.NamespacedName, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB) (*batchv1.CronJob, error) {
+	if !backup.IsBinlogArchiveEnabled() || backup.Spec.BinlogArchive.Schedule == nil {
+		return nil, errors.New("binlog archive schedule is mandatory when building a CronJob")
+	}
+	objMeta :=
+		metadata.NewMetadataBuilder(key).
+			WithMariaDB(mariadb).
+			Build()
+
+	cmdOpts := []command.BackupOpt{
+		command.WithBackup(
+			batchStorageMountPath,
+			batchBinlogTargetFilePath,
+		),
+		command.WithBackupMaxRetention(backup.Spec.MaxRetention.Duration),
+		command.WithBackupUserEnv(batchUserEnv),
+		command.WithBackupPasswordEnv(batchPasswordEnv),
+		command.WithBackupLogLevel(backup.Spec.LogLevel),
+	}
+	cmdOpts = append(cmdOpts, storageOpts(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob, backup.Spec.Storage.GCS)...)
+
+	cmd, err := command.NewBackupCommand(cmdOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building binlog archive command: %v", err)
+	}
+
+	volume, err := backup.Volume()
+	if err != nil {
+		return nil, fmt.Errorf("error getting volume from Backup: %v", err)
+	}
+	volumes, volumeSources := jobBatchStorageVolume(volume, backup.Spec.Storage.S3, backup.Spec.Storage.GCS)
+
+	opts := []jobOption{
+		withJobMeta(objMeta),
+		withJobVolumes(volumes...),
+		withJobInitContainers(
+			jobMariadbOperatorContainer(
+				cmd.MariadbOperatorBinlogList(),
+				volumeSources,
+				jobStorageEnv(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob),
+				backup.Spec.Resources,
+				mariadb,
+				b.env,
+				backup.Spec.SecurityContext,
+			),
+			jobMariadbContainer(
+				cmd.MariadbBinlogArchive(mariadb),
+				volumeSources,
+				jobEnv(mariadb),
+				backup.Spec.Resources,
+				mariadb,
+				backup.Spec.SecurityContext,
+			),
+		),
+		withJobContainers(
+			jobMariadbOperatorContainer(
+				cmd.MariadbOperatorBinlogPush(),
+				volumeSources,
+				jobStorageEnv(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob),
+				backup.Spec.Resources,
+				mariadb,
+				b.env,
+				backup.Spec.SecurityContext,
+			),
+		),
+		withJobBackoffLimit(backup.Spec.BackoffLimit),
+		withJobRestartPolicy(backup.Spec.RestartPolicy),
+		withAffinity(backup.Spec.Affinity),
+		withNodeSelector(backup.Spec.NodeSelector),
+		withTolerations(backup.Spec.Tolerations...),
+		withPodSecurityContext(backup.Spec.PodSecurityContext),
+		withServiceAccountName(backup.Spec.ServiceAccountName),
+	}
+
+	builder, err := newJobBuilder(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building binlog archive Job: %v", err)
+	}
+	job := builder.build()
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: objMeta,
+		Spec: batchv1.CronJobSpec{
+			Schedule:          backup.Spec.BinlogArchive.Schedule.Cron,
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+			Suspend:           &backup.Spec.BinlogArchive.Schedule.Suspend,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: job.ObjectMeta,
+				Spec:       job.Spec,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(backup, cronJob, b.scheme); err != nil {
+		return nil, fmt.Errorf("error setting controller reference to CronJob: %v", err)
+	}
+	return cronJob, nil
+}
+
+// BuildBackupVerifyJob builds a Job that restores the last Backup into an ephemeral MariaDB instance to verify it.
+func (b *Builder) BuildBackupVerifyJob(key types.NamespacedName, backup *mariadbv1alpha1.Backup,
 	mariadb *mariadbv1alpha1.MariaDB) (*batchv1.Job, error) {
+	if backup.Spec.Verify == nil {
+		return nil, errors.New("verify must be set in Backup")
+	}
+	if backup.Status.LastSuccessfulBackupTime == nil {
+		return nil, errors.New("no successful Backup to verify")
+	}
+	backupTime := backup.Status.LastSuccessfulBackupTime.Time
 	objMeta :=
 		metadata.NewMetadataBuilder(key).
 			WithMariaDB(mariadb).
+			WithAnnotations(map[string]string{
+				annotation.BackupTimeAnnotation: backupTime.UTC().Format(time.RFC3339),
+			}).
 			Build()
+
 	cmdOpts := []command.BackupOpt{
 		command.WithBackup(
 			batchStorageMountPath,
-			batchBackupTargetFilePath,
+			batchVerifyTargetFilePath,
 		),
-		command.WithBackupTargetTime(restore.Spec.RestoreSource.TargetRecoveryTimeOrDefault()),
+		command.WithBackupTargetTime(backupTime),
+		command.WithBackupVerify(batchVerifyDatadirPath, batchVerifySqlQueryEnv),
 		command.WithBackupUserEnv(batchUserEnv),
 		command.WithBackupPasswordEnv(batchPasswordEnv),
-		command.WithBackupLogLevel(restore.Spec.LogLevel),
-		command.WithBackupDumpOpts(restore.Spec.Args),
+		command.WithBackupLogLevel(backup.Spec.LogLevel),
+	}
+	cmdOpts = append(cmdOpts, storageOpts(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob, backup.Spec.Storage.GCS)...)
+	if backup.IsPhysical() {
+		cmdOpts = append(cmdOpts, command.WithBackupPhysical(batchVerifyDatadirPath, batchVerifyMountPath))
+	}
+	if backup.IsEncrypted() {
+		cmdOpts = append(cmdOpts, command.WithBackupDecryption(
+			encryptionKeyPath(backup.Spec.Encryption),
+			batchDecryptMountPath,
+		))
 	}
-	cmdOpts = append(cmdOpts, s3Opts(restore.Spec.S3)...)
 
 	cmd, err := command.NewBackupCommand(cmdOpts...)
 	if err != nil {
-		return nil, fmt.Errorf("error building restore command: %v", err)
+		return nil, fmt.Errorf("error building verify command: %v", err)
+	}
+
+	volume, err := backup.Volume()
+	if err != nil {
+		return nil, fmt.Errorf("error getting volume from Backup: %v", err)
+	}
+	volumes, volumeSources := jobBatchStorageVolume(volume, backup.Spec.Storage.S3, backup.Spec.Storage.GCS)
+	volumes = append(volumes, corev1.Volume{
+		Name: batchVerifyVolume,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+	volumeSources = append(volumeSources, corev1.VolumeMount{
+		Name:      batchVerifyVolume,
+		MountPath: batchVerifyMountPath,
+	})
+
+	operatorVolumeSources := volumeSources
+	verifyVolumeSources := jobCloneVolumeMounts(volumeSources)
+	if backup.IsEncrypted() {
+		encryptionVolumes, encryptionVolumeMounts := jobEncryptionVolumes(backup.Spec.Encryption)
+		decryptVolumes, decryptVolumeMounts := jobDecryptVolumes()
+		volumes = append(volumes, encryptionVolumes...)
+		volumes = append(volumes, decryptVolumes...)
+		operatorVolumeSources = append(jobCloneVolumeMounts(volumeSources), encryptionVolumeMounts...)
+		operatorVolumeSources = append(operatorVolumeSources, decryptVolumeMounts...)
+		verifyVolumeSources = append(verifyVolumeSources, decryptVolumeMounts...)
+	}
+
+	verifyEnv := []corev1.EnvVar{
+		{
+			Name:  batchVerifySqlQueryEnv,
+			Value: backup.Spec.Verify.SqlQueryOrDefault(),
+		},
+	}
+	verifyResources := backup.Spec.Verify.Resources
+	if verifyResources == nil {
+		verifyResources = backup.Spec.Resources
 	}
-	volumes, volumeSources := jobBatchStorageVolume(restore.Spec.RestoreSource.Volume, restore.Spec.S3)
 
 	jobOpts := []jobOption{
 		withJobMeta(objMeta),
@@ -169,31 +434,178 @@ func (b *Builder) BuildRestoreJob(key types.NamespacedName, restore *mariadbv1al
 		withJobInitContainers(
 			jobMariadbOperatorContainer(
 				cmd.MariadbOperatorRestore(),
-				volumeSources,
-				jobS3Env(restore.Spec.S3),
-				restore.Spec.Resources,
+				operatorVolumeSources,
+				jobStorageEnv(backup.Spec.Storage.S3, backup.Spec.Storage.AzureBlob),
+				backup.Spec.Resources,
 				mariadb,
 				b.env,
-				restore.Spec.SecurityContext,
+				backup.Spec.SecurityContext,
 			),
 		),
 		withJobContainers(
 			jobMariadbContainer(
-				cmd.MariadbRestore(mariadb),
-				volumeSources,
+				cmd.MariadbVerify(),
+				verifyVolumeSources,
+				verifyEnv,
+				verifyResources,
+				mariadb,
+				backup.Spec.SecurityContext,
+			),
+		),
+		withJobBackoffLimit(backup.Spec.BackoffLimit),
+		withJobRestartPolicy(corev1.RestartPolicyNever),
+		withAffinity(backup.Spec.Affinity),
+		withNodeSelector(backup.Spec.NodeSelector),
+		withTolerations(backup.Spec.Tolerations...),
+		withPodSecurityContext(backup.Spec.PodSecurityContext),
+		withServiceAccountName(backup.Spec.ServiceAccountName),
+	}
+
+	builder, err := newJobBuilder(jobOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building verify Job: %v", err)
+	}
+
+	job := builder.build()
+	if err := controllerutil.SetControllerReference(backup, job, b.scheme); err != nil {
+		return nil, fmt.Errorf("error setting controller reference to Job: %v", err)
+	}
+	return job, nil
+}
+
+func (b *Builder) BuildRestoreJob(key types.NamespacedName, restore *mariadbv1alpha1.Restore,
+	mariadb *mariadbv1alpha1.MariaDB) (*batchv1.Job, error) {
+	objMeta :=
+		metadata.NewMetadataBuilder(key).
+			WithMariaDB(mariadb).
+			Build()
+	cmdOpts := []command.BackupOpt{
+		command.WithBackup(
+			batchStorageMountPath,
+			batchBackupTargetFilePath,
+		),
+		command.WithBackupTargetTime(restore.Spec.RestoreSource.TargetRecoveryTimeOrDefault()),
+		command.WithBackupReplayBinlogs(restore.Spec.RestoreSource.IsReplayBinlogsEnabled()),
+		command.WithBackupRestoreDatabase(restore.Spec.RestoreSource.Database, restore.Spec.RestoreSource.TargetDatabase),
+		command.WithBackupUserEnv(batchUserEnv),
+		command.WithBackupPasswordEnv(batchPasswordEnv),
+		command.WithBackupLogLevel(restore.Spec.LogLevel),
+		command.WithBackupDumpOpts(restore.Spec.Args),
+	}
+	cmdOpts = append(cmdOpts, storageOpts(restore.Spec.S3, restore.Spec.AzureBlob, restore.Spec.GCS)...)
+	if restore.Spec.RestoreSource.IsPhysical() {
+		if mariadb.IsEphemeralStorageEnabled() {
+			return nil, errors.New("physical restores are not supported with ephemeral storage")
+		}
+		cmdOpts = append(cmdOpts, command.WithBackupPhysical(MariadbStorageMountPath, batchStagingMountPath))
+	}
+	if restore.Spec.RestoreSource.IsEncrypted() {
+		cmdOpts = append(cmdOpts, command.WithBackupDecryption(
+			encryptionKeyPath(restore.Spec.RestoreSource.Encryption),
+			batchDecryptMountPath,
+		))
+	}
+	if restore.Spec.RestoreSource.IsMydumperEngine() {
+		if restore.Spec.RestoreSource.IsPhysical() {
+			return nil, errors.New("the Mydumper engine is only supported by logical restores")
+		}
+		cmdOpts = append(cmdOpts, command.WithBackupMydumper(nil))
+	}
+	if restore.Spec.RestoreSource.IsStreaming() {
+		if restore.Spec.RestoreSource.IsPhysical() || restore.Spec.RestoreSource.IsEncrypted() ||
+			restore.Spec.RestoreSource.IsReplayBinlogsEnabled() {
+			return nil, errors.New("streaming restores are not supported by physical nor encrypted backups, nor along with replaying binlogs")
+		}
+		cmdOpts = append(cmdOpts, command.WithS3Streaming())
+	}
+
+	cmd, err := command.NewBackupCommand(cmdOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building restore command: %v", err)
+	}
+	volumes, volumeSources := jobBatchStorageVolume(restore.Spec.RestoreSource.Volume, restore.Spec.S3, restore.Spec.GCS)
+
+	restoreCmd := cmd.MariadbRestore(mariadb)
+	operatorVolumeSources := volumeSources
+	restoreVolumeSources := jobCloneVolumeMounts(volumeSources)
+	if restore.Spec.RestoreSource.IsEncrypted() {
+		encryptionVolumes, encryptionVolumeMounts := jobEncryptionVolumes(restore.Spec.RestoreSource.Encryption)
+		decryptVolumes, decryptVolumeMounts := jobDecryptVolumes()
+		volumes = append(volumes, encryptionVolumes...)
+		volumes = append(volumes, decryptVolumes...)
+		operatorVolumeSources = append(jobCloneVolumeMounts(volumeSources), encryptionVolumeMounts...)
+		operatorVolumeSources = append(operatorVolumeSources, decryptVolumeMounts...)
+		restoreVolumeSources = append(restoreVolumeSources, decryptVolumeMounts...)
+	}
+	if restore.Spec.RestoreSource.IsPhysical() {
+		restoreCmd = cmd.MariaBackupRestore()
+
+		dataVolumes, dataVolumeMounts := jobPhysicalVolumes(mariadb.StoragePVCKey(0).Name, false)
+		volumes = append(volumes, dataVolumes...)
+		restoreVolumeSources = append(restoreVolumeSources, dataVolumeMounts...)
+	}
+
+	operatorContainer := jobMariadbOperatorContainer(
+		cmd.MariadbOperatorRestore(),
+		operatorVolumeSources,
+		jobStorageEnv(restore.Spec.S3, restore.Spec.AzureBlob),
+		restore.Spec.Resources,
+		mariadb,
+		b.env,
+		restore.Spec.SecurityContext,
+	)
+	restoreContainer := jobMariadbContainer(
+		restoreCmd,
+		restoreVolumeSources,
+		jobEnv(mariadb),
+		restore.Spec.Resources,
+		mariadb,
+		restore.Spec.SecurityContext,
+	)
+
+	initContainers := []corev1.Container{
+		operatorContainer,
+	}
+	// Backups taken with mydumper are loaded by myloader, whereas the rest of backups are restored by the mariadb container.
+	if restore.Spec.RestoreSource.IsMydumperEngine() {
+		initContainers = append(initContainers,
+			jobMydumperContainer(
+				cmd.Myloader(mariadb),
+				restoreVolumeSources,
 				jobEnv(mariadb),
 				restore.Spec.Resources,
 				mariadb,
+				b.env,
 				restore.Spec.SecurityContext,
 			),
-		),
+		)
+	}
+	// When streaming, the backup is restored while it is being downloaded, therefore both containers run concurrently.
+	containerOpts := []jobOption{
+		withJobInitContainers(initContainers...),
+		withJobContainers(restoreContainer),
+	}
+	restartPolicy := restore.Spec.RestartPolicy
+	if restore.Spec.RestoreSource.IsStreaming() {
+		containerOpts = []jobOption{
+			withJobContainers(operatorContainer, restoreContainer),
+		}
+		// Restarting a single container would leave it waiting on a stream that the other one has already abandoned.
+		restartPolicy = corev1.RestartPolicyNever
+	}
+
+	jobOpts := []jobOption{
+		withJobMeta(objMeta),
+		withJobVolumes(volumes...),
 		withJobBackoffLimit(restore.Spec.BackoffLimit),
-		withJobRestartPolicy(restore.Spec.RestartPolicy),
+		withJobRestartPolicy(restartPolicy),
 		withAffinity(restore.Spec.Affinity),
 		withNodeSelector(restore.Spec.NodeSelector),
 		withTolerations(restore.Spec.Tolerations...),
 		withPodSecurityContext(restore.Spec.PodSecurityContext),
+		withServiceAccountName(restore.Spec.ServiceAccountName),
 	}
+	jobOpts = append(jobOpts, containerOpts...)
 
 	builder, err := newJobBuilder(jobOpts...)
 	if err != nil {
@@ -295,6 +707,119 @@ func (b *Builder) BuildSqlCronJob(key types.NamespacedName, sqlJob *mariadbv1alp
 	return cronJob, nil
 }
 
+func jobPhysicalVolumes(pvcName string, readOnly bool) ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes := []corev1.Volume{
+		{
+			Name: batchDataVolume,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvcName,
+				},
+			},
+		},
+		{
+			Name: batchStagingVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      batchDataVolume,
+			MountPath: MariadbStorageMountPath,
+			ReadOnly:  readOnly,
+		},
+		{
+			Name:      batchStagingVolume,
+			MountPath: batchStagingMountPath,
+		},
+	}
+	return volumes, volumeMounts
+}
+
+func jobEncryptionVolumes(encryption *mariadbv1alpha1.BackupEncryption) ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes := []corev1.Volume{
+		{
+			Name: batchEncryptionVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: encryption.KeySecretKeyRef.Name,
+				},
+			},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      batchEncryptionVolume,
+			MountPath: batchEncryptionMountPath,
+			ReadOnly:  true,
+		},
+	}
+	return volumes, volumeMounts
+}
+
+func jobDecryptVolumes() ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes := []corev1.Volume{
+		{
+			Name: batchDecryptVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      batchDecryptVolume,
+			MountPath: batchDecryptMountPath,
+		},
+	}
+	return volumes, volumeMounts
+}
+
+// jobCloneVolumeMounts copies the VolumeMounts, so they can be appended without affecting other containers.
+func jobCloneVolumeMounts(volumeMounts []corev1.VolumeMount) []corev1.VolumeMount {
+	return append([]corev1.VolumeMount{}, volumeMounts...)
+}
+
+func encryptionKeyPath(encryption *mariadbv1alpha1.BackupEncryption) string {
+	return filepath.Join(batchEncryptionMountPath, encryption.KeySecretKeyRef.Key)
+}
+
+// jobPodAffinity schedules the Job in the same Node as the given Pod, so its storage can be mounted.
+func jobPodAffinity(affinity *corev1.Affinity, podName string) *corev1.Affinity {
+	podAffinity := affinity.DeepCopy()
+	if podAffinity == nil {
+		podAffinity = &corev1.Affinity{}
+	}
+	if podAffinity.PodAffinity == nil {
+		podAffinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	podAffinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+		podAffinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					appsv1.StatefulSetPodNameLabel: podName,
+				},
+			},
+			TopologyKey: corev1.LabelHostname,
+		},
+	)
+	return podAffinity
+}
+
+// storageOpts returns the command options for the object storage, where S3 has priority over AzureBlob, and AzureBlob over GCS.
+func storageOpts(s3 *mariadbv1alpha1.S3, azureBlob *mariadbv1alpha1.AzureBlob, gcs *mariadbv1alpha1.GCS) []command.BackupOpt {
+	if s3 != nil {
+		return s3Opts(s3)
+	}
+	if azureBlob != nil {
+		return azureBlobOpts(azureBlob)
+	}
+	return gcsOpts(gcs)
+}
+
 func s3Opts(s3 *mariadbv1alpha1.S3) []command.BackupOpt {
 	if s3 == nil {
 		return nil
@@ -314,5 +839,84 @@ func s3Opts(s3 *mariadbv1alpha1.S3) []command.BackupOpt {
 		}
 		cmdOpts = append(cmdOpts, command.WithS3TLS(caCertPath))
 	}
+	if s3.IsIAM() {
+		cmdOpts = append(cmdOpts, command.WithS3IAM())
+	}
+	if sse := s3.ServerSideEncryption; sse != nil {
+		customerKeyPath := ""
+		if sse.CustomerKeySecretKeyRef != nil {
+			customerKeyPath = filepath.Join(batchS3SSEMountPath, sse.CustomerKeySecretKeyRef.Key)
+		}
+		cmdOpts = append(cmdOpts, command.WithS3SSE(string(sse.Type), sse.KMSKeyID, customerKeyPath))
+	}
+	if s3.StorageClass != "" {
+		cmdOpts = append(cmdOpts, command.WithS3StorageClass(s3.StorageClass))
+	}
+	if len(s3.Tags) > 0 {
+		cmdOpts = append(cmdOpts, command.WithS3Tags(s3.Tags))
+	}
 	return cmdOpts
 }
+
+func azureBlobOpts(azureBlob *mariadbv1alpha1.AzureBlob) []command.BackupOpt {
+	if azureBlob == nil {
+		return nil
+	}
+	return []command.BackupOpt{
+		command.WithAzureBlob(
+			azureBlob.Container,
+			azureBlob.StorageAccount,
+			azureBlob.ServiceURL,
+			azureBlob.Prefix,
+		),
+	}
+}
+
+func gcsOpts(gcs *mariadbv1alpha1.GCS) []command.BackupOpt {
+	if gcs == nil {
+		return nil
+	}
+	credentialsPath := ""
+	if gcs.ServiceAccountKeySecretKeyRef != nil {
+		credentialsPath = filepath.Join(batchGCSCredentialsMountPath, gcs.ServiceAccountKeySecretKeyRef.Key)
+	}
+	return []command.BackupOpt{
+		command.WithGCS(
+			gcs.Bucket,
+			gcs.Prefix,
+			credentialsPath,
+		),
+	}
+}
+
+// jobStorageEnv returns the credentials environment for the object storage, where S3 has priority over AzureBlob.
+func jobStorageEnv(s3 *mariadbv1alpha1.S3, azureBlob *mariadbv1alpha1.AzureBlob) []corev1.EnvVar {
+	if s3 != nil {
+		return jobS3Env(s3)
+	}
+	return jobAzureBlobEnv(azureBlob)
+}
+
+func jobAzureBlobEnv(azureBlob *mariadbv1alpha1.AzureBlob) []corev1.EnvVar {
+	if azureBlob == nil {
+		return nil
+	}
+	var env []corev1.EnvVar
+	if azureBlob.AccountKeySecretKeyRef != nil {
+		env = append(env, corev1.EnvVar{
+			Name: batchAzureBlobAccountKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: azureBlob.AccountKeySecretKeyRef,
+			},
+		})
+	}
+	if azureBlob.SASTokenSecretKeyRef != nil {
+		env = append(env, corev1.EnvVar{
+			Name: batchAzureBlobSASToken,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: azureBlob.SASTokenSecretKeyRef,
+			},
+		})
+	}
+	return env
+}
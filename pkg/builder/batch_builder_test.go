@@ -0,0 +1,438 @@
+package builder
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/environment"
+	"github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBuildBackupVerifyJob(t *testing.T) {
+	builder := newTestBuilder(t)
+	mariadb := newTestMariaDB()
+	backupTime := time.Date(2023, 12, 22, 15, 0, 0, 0, time.UTC)
+	newBackup := func(verify *mariadbv1alpha1.BackupVerify, lastSuccessfulBackupTime *metav1.Time) *mariadbv1alpha1.Backup {
+		return &mariadbv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backup",
+				Namespace: "default",
+			},
+			Spec: mariadbv1alpha1.BackupSpec{
+				Storage: mariadbv1alpha1.BackupStorage{
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				Verify: verify,
+			},
+			Status: mariadbv1alpha1.BackupStatus{
+				LastSuccessfulBackupTime: lastSuccessfulBackupTime,
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		backup       *mariadbv1alpha1.Backup
+		wantSqlQuery string
+		wantErr      bool
+	}{
+		{
+			name:    "no verify",
+			backup:  newBackup(nil, &metav1.Time{Time: backupTime}),
+			wantErr: true,
+		},
+		{
+			name: "no successful backup",
+			backup: newBackup(&mariadbv1alpha1.BackupVerify{
+				Enabled: true,
+			}, nil),
+			wantErr: true,
+		},
+		{
+			name: "default query",
+			backup: newBackup(&mariadbv1alpha1.BackupVerify{
+				Enabled: true,
+			}, &metav1.Time{Time: backupTime}),
+			wantSqlQuery: (&mariadbv1alpha1.BackupVerify{}).SqlQueryOrDefault(),
+			wantErr:      false,
+		},
+		{
+			name: "custom query",
+			backup: newBackup(&mariadbv1alpha1.BackupVerify{
+				Enabled:  true,
+				SqlQuery: "SELECT COUNT(*) FROM test.users;",
+			}, &metav1.Time{Time: backupTime}),
+			wantSqlQuery: "SELECT COUNT(*) FROM test.users;",
+			wantErr:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := builder.BuildBackupVerifyJob(tt.backup.VerifyKey(), tt.backup, mariadb)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expecting error to be non nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			assertVerifyJob(t, job, tt.backup, backupTime, tt.wantSqlQuery)
+		})
+	}
+}
+
+func TestBuildRestoreJob(t *testing.T) {
+	builder := newTestBuilder(t)
+	mariadb := newTestMariaDB()
+	newRestore := func(engine mariadbv1alpha1.BackupEngine) *mariadbv1alpha1.Restore {
+		return &mariadbv1alpha1.Restore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restore",
+				Namespace: "default",
+			},
+			Spec: mariadbv1alpha1.RestoreSpec{
+				RestoreSource: mariadbv1alpha1.RestoreSource{
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+					Engine: engine,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		restore            *mariadbv1alpha1.Restore
+		wantInitContainers []string
+		wantRestoreArgs    string
+	}{
+		{
+			name:               "default engine",
+			restore:            newRestore(""),
+			wantInitContainers: []string{"mariadb-operator"},
+			wantRestoreArgs:    "the Mydumper engine must be set to restore it; exit 1",
+		},
+		{
+			name:               "mariadb-dump engine",
+			restore:            newRestore(mariadbv1alpha1.BackupEngineMariadbDump),
+			wantInitContainers: []string{"mariadb-operator"},
+			wantRestoreArgs:    "the Mydumper engine must be set to restore it; exit 1",
+		},
+		{
+			name:               "mydumper engine",
+			restore:            newRestore(mariadbv1alpha1.BackupEngineMydumper),
+			wantInitContainers: []string{"mariadb-operator", "mydumper"},
+			wantRestoreArgs:    "echo 💾 Backup restored by myloader; exit 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := builder.BuildRestoreJob(client.ObjectKeyFromObject(tt.restore), tt.restore, mariadb)
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			podSpec := job.Spec.Template.Spec
+
+			var initContainers []string
+			for _, container := range podSpec.InitContainers {
+				initContainers = append(initContainers, container.Name)
+			}
+			if !reflect.DeepEqual(initContainers, tt.wantInitContainers) {
+				t.Fatalf("unexpected init containers, expected: %v got: %v", tt.wantInitContainers, initContainers)
+			}
+			for _, container := range podSpec.InitContainers {
+				if container.Name == "mydumper" && container.Image != "mydumper/mydumper:v0.15.1-3" {
+					t.Fatalf("unexpected mydumper image, expected: %s got: %s", "mydumper/mydumper:v0.15.1-3", container.Image)
+				}
+			}
+
+			if len(podSpec.Containers) != 1 || podSpec.Containers[0].Name != "mariadb" {
+				t.Fatalf("expecting a single mariadb container, got: %v", podSpec.Containers)
+			}
+			if args := strings.Join(podSpec.Containers[0].Args, " "); !strings.Contains(args, tt.wantRestoreArgs) {
+				t.Fatalf("expecting restore args to contain: %s\nargs: %s", tt.wantRestoreArgs, args)
+			}
+		})
+	}
+}
+
+func TestBuildStreamingJobRestartPolicy(t *testing.T) {
+	builder := newTestBuilder(t)
+	mariadb := newTestMariaDB()
+	newS3 := func(streaming bool) *mariadbv1alpha1.S3 {
+		return &mariadbv1alpha1.S3{
+			Bucket:    "test",
+			Endpoint:  "test",
+			IAM:       true,
+			Streaming: streaming,
+		}
+	}
+	newBackup := func(streaming bool) *mariadbv1alpha1.Backup {
+		return &mariadbv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backup",
+				Namespace: "default",
+			},
+			Spec: mariadbv1alpha1.BackupSpec{
+				Storage: mariadbv1alpha1.BackupStorage{
+					S3: newS3(streaming),
+				},
+				RestartPolicy: corev1.RestartPolicyOnFailure,
+			},
+		}
+	}
+	newRestore := func(streaming bool) *mariadbv1alpha1.Restore {
+		return &mariadbv1alpha1.Restore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restore",
+				Namespace: "default",
+			},
+			Spec: mariadbv1alpha1.RestoreSpec{
+				RestoreSource: mariadbv1alpha1.RestoreSource{
+					S3: newS3(streaming),
+					Volume: &corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+				RestartPolicy: corev1.RestartPolicyOnFailure,
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		buildJob          func() (*batchv1.Job, error)
+		wantRestartPolicy corev1.RestartPolicy
+	}{
+		{
+			name: "backup",
+			buildJob: func() (*batchv1.Job, error) {
+				backup := newBackup(false)
+				return builder.BuildBackupJob(client.ObjectKeyFromObject(backup), backup, mariadb, nil)
+			},
+			wantRestartPolicy: corev1.RestartPolicyOnFailure,
+		},
+		{
+			name: "streaming backup",
+			buildJob: func() (*batchv1.Job, error) {
+				backup := newBackup(true)
+				return builder.BuildBackupJob(client.ObjectKeyFromObject(backup), backup, mariadb, nil)
+			},
+			wantRestartPolicy: corev1.RestartPolicyNever,
+		},
+		{
+			name: "restore",
+			buildJob: func() (*batchv1.Job, error) {
+				restore := newRestore(false)
+				return builder.BuildRestoreJob(client.ObjectKeyFromObject(restore), restore, mariadb)
+			},
+			wantRestartPolicy: corev1.RestartPolicyOnFailure,
+		},
+		{
+			name: "streaming restore",
+			buildJob: func() (*batchv1.Job, error) {
+				restore := newRestore(true)
+				return builder.BuildRestoreJob(client.ObjectKeyFromObject(restore), restore, mariadb)
+			},
+			wantRestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := tt.buildJob()
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if restartPolicy := job.Spec.Template.Spec.RestartPolicy; restartPolicy != tt.wantRestartPolicy {
+				t.Fatalf("unexpected restart policy, expected: %s got: %s", tt.wantRestartPolicy, restartPolicy)
+			}
+		})
+	}
+}
+
+func TestBuildBackupJobS3Credentials(t *testing.T) {
+	builder := newTestBuilder(t)
+	mariadb := newTestMariaDB()
+	newBackup := func(s3 *mariadbv1alpha1.S3) *mariadbv1alpha1.Backup {
+		s3.Bucket = "test"
+		s3.Endpoint = "test"
+		return &mariadbv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "backup",
+				Namespace: "default",
+			},
+			Spec: mariadbv1alpha1.BackupSpec{
+				Storage: mariadbv1alpha1.BackupStorage{
+					S3: s3,
+				},
+			},
+		}
+	}
+	secretKeySelector := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: "s3",
+			},
+			Key: key,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		backup  *mariadbv1alpha1.Backup
+		wantEnv []string
+		wantIAM bool
+	}{
+		{
+			name: "static keys",
+			backup: newBackup(&mariadbv1alpha1.S3{
+				AccessKeyIdSecretKeyRef:     secretKeySelector("access-key-id"),
+				SecretAccessKeySecretKeyRef: secretKeySelector("secret-access-key"),
+			}),
+			wantEnv: []string{batchS3AccessKeyId, batchS3SecretAccessKey},
+			wantIAM: false,
+		},
+		{
+			name: "static keys with session token",
+			backup: newBackup(&mariadbv1alpha1.S3{
+				AccessKeyIdSecretKeyRef:     secretKeySelector("access-key-id"),
+				SecretAccessKeySecretKeyRef: secretKeySelector("secret-access-key"),
+				SessionTokenSecretKeyRef:    secretKeySelector("session-token"),
+			}),
+			wantEnv: []string{batchS3AccessKeyId, batchS3SecretAccessKey, batchS3SessionTokenKey},
+			wantIAM: false,
+		},
+		{
+			name: "IAM",
+			backup: newBackup(&mariadbv1alpha1.S3{
+				IAM: true,
+			}),
+			wantEnv: nil,
+			wantIAM: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := builder.BuildBackupJob(client.ObjectKeyFromObject(tt.backup), tt.backup, mariadb, nil)
+			if err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			podSpec := job.Spec.Template.Spec
+			if len(podSpec.Containers) != 1 || podSpec.Containers[0].Name != "mariadb-operator" {
+				t.Fatalf("expecting a single mariadb-operator container, got: %v", podSpec.Containers)
+			}
+			container := podSpec.Containers[0]
+
+			var env []string
+			for _, e := range container.Env {
+				if strings.HasPrefix(e.Name, "AWS_") {
+					env = append(env, e.Name)
+				}
+			}
+			if !reflect.DeepEqual(env, tt.wantEnv) {
+				t.Fatalf("unexpected S3 env, expected: %v got: %v", tt.wantEnv, env)
+			}
+			if iam := strings.Contains(strings.Join(container.Args, " "), "--s3-iam"); iam != tt.wantIAM {
+				t.Fatalf("unexpected --s3-iam flag, expected: %v got: %v", tt.wantIAM, iam)
+			}
+		})
+	}
+}
+
+func assertVerifyJob(t *testing.T, job *batchv1.Job, backup *mariadbv1alpha1.Backup, backupTime time.Time,
+	wantSqlQuery string) {
+	if job.Name != "backup-verify" {
+		t.Fatalf("unexpected Job name, expected: %s got: %s", "backup-verify", job.Name)
+	}
+	if annotation := job.Annotations[metadata.BackupTimeAnnotation]; annotation != backupTime.Format(time.RFC3339) {
+		t.Fatalf("unexpected backup time annotation, expected: %s got: %s", backupTime.Format(time.RFC3339), annotation)
+	}
+	if !metav1.IsControlledBy(job, backup) {
+		t.Fatal("expecting Job to be controlled by the Backup")
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if podSpec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Fatalf("unexpected restart policy, expected: %s got: %s", corev1.RestartPolicyNever, podSpec.RestartPolicy)
+	}
+	if len(podSpec.InitContainers) != 1 || podSpec.InitContainers[0].Name != "mariadb-operator" {
+		t.Fatalf("expecting a single mariadb-operator init container, got: %v", podSpec.InitContainers)
+	}
+	if args := strings.Join(podSpec.InitContainers[0].Args, " "); !strings.Contains(args, "restore") {
+		t.Fatalf("expecting mariadb-operator init container to pull the backup, got args: %s", args)
+	}
+	if len(podSpec.Containers) != 1 || podSpec.Containers[0].Name != "mariadb" {
+		t.Fatalf("expecting a single mariadb container, got: %v", podSpec.Containers)
+	}
+
+	var sqlQuery string
+	for _, env := range podSpec.Containers[0].Env {
+		if env.Name == batchVerifySqlQueryEnv {
+			sqlQuery = env.Value
+		}
+	}
+	if sqlQuery != wantSqlQuery {
+		t.Fatalf("unexpected verification query, expected: %s got: %s", wantSqlQuery, sqlQuery)
+	}
+
+	hasVerifyVolume := false
+	for _, volume := range podSpec.Volumes {
+		if volume.Name == batchVerifyVolume && volume.EmptyDir != nil {
+			hasVerifyVolume = true
+		}
+	}
+	if !hasVerifyVolume {
+		t.Fatalf("expecting Job to have an ephemeral %s volume", batchVerifyVolume)
+	}
+}
+
+func newTestBuilder(t *testing.T) *Builder {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding client-go scheme: %v", err)
+	}
+	if err := mariadbv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding mariadb scheme: %v", err)
+	}
+	return NewBuilder(scheme, &environment.Environment{
+		MariadbOperatorName:      "mariadb-operator",
+		MariadbOperatorNamespace: "default",
+		MariadbOperatorSAPath:    "/var/run/secrets/kubernetes.io/serviceaccount/token",
+		MariadbOperatorImage:     "ghcr.io/mariadb-operator/mariadb-operator:test",
+		RelatedMariadbImage:      "mariadb:11.0.3",
+		RelatedMaxscaleImage:     "mariadb/maxscale:23.08",
+		RelatedExporterImage:     "prom/mysqld-exporter:v0.15.1",
+		RelatedMydumperImage:     "mydumper/mydumper:v0.15.1-3",
+	})
+}
+
+func newTestMariaDB() *mariadbv1alpha1.MariaDB {
+	return &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Image: "mariadb:11.0.3",
+			Port:  3306,
+		},
+	}
+}
@@ -74,6 +74,12 @@ func withPodSecurityContext(podSecurityContext *corev1.PodSecurityContext) jobOp
 	}
 }
 
+func withServiceAccountName(serviceAccountName *string) jobOption {
+	return func(b *jobBuilder) {
+		b.serviceAccountName = serviceAccountName
+	}
+}
+
 type jobBuilder struct {
 	meta               *metav1.ObjectMeta
 	volumes            []corev1.Volume
@@ -85,6 +91,7 @@ type jobBuilder struct {
 	nodeSelector       map[string]string
 	tolerations        []corev1.Toleration
 	podSecurityContext *corev1.PodSecurityContext
+	serviceAccountName *string
 }
 
 func newJobBuilder(opts ...jobOption) (*jobBuilder, error) {
@@ -123,6 +130,9 @@ func (b *jobBuilder) build() *batchv1.Job {
 	if b.restartPolicy != nil {
 		template.Spec.RestartPolicy = *b.restartPolicy
 	}
+	if b.serviceAccountName != nil {
+		template.Spec.ServiceAccountName = *b.serviceAccountName
+	}
 
 	job := &batchv1.Job{
 		ObjectMeta: *b.meta,
@@ -161,12 +171,19 @@ func jobMariadbOperatorContainer(cmd *cmd.Command, volumeMounts []corev1.VolumeM
 	return jobContainer("mariadb-operator", cmd, env.MariadbOperatorImage, volumeMounts, envVar, resources, mariadb, securityContext)
 }
 
+func jobMydumperContainer(cmd *cmd.Command, volumeMounts []corev1.VolumeMount, envVar []v1.EnvVar,
+	resources *corev1.ResourceRequirements, mariadb *mariadbv1alpha1.MariaDB, env *environment.Environment,
+	securityContext *corev1.SecurityContext) corev1.Container {
+	return jobContainer("mydumper", cmd, env.RelatedMydumperImage, volumeMounts, envVar, resources, mariadb, securityContext)
+}
+
 func jobMariadbContainer(cmd *cmd.Command, volumeMounts []corev1.VolumeMount, envVar []v1.EnvVar,
 	resources *corev1.ResourceRequirements, mariadb *mariadbv1alpha1.MariaDB, securityContext *corev1.SecurityContext) corev1.Container {
 	return jobContainer("mariadb", cmd, mariadb.Spec.Image, volumeMounts, envVar, resources, mariadb, securityContext)
 }
 
-func jobBatchStorageVolume(volumeSource *corev1.VolumeSource, s3 *mariadbv1alpha1.S3) ([]corev1.Volume, []corev1.VolumeMount) {
+func jobBatchStorageVolume(volumeSource *corev1.VolumeSource, s3 *mariadbv1alpha1.S3,
+	gcs *mariadbv1alpha1.GCS) ([]corev1.Volume, []corev1.VolumeMount) {
 	volumes :=
 		[]corev1.Volume{
 			{
@@ -194,6 +211,36 @@ func jobBatchStorageVolume(volumeSource *corev1.VolumeSource, s3 *mariadbv1alpha
 			MountPath: batchS3PKIMountPath,
 		})
 	}
+	if s3 != nil && s3.ServerSideEncryption != nil && s3.ServerSideEncryption.CustomerKeySecretKeyRef != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: batchS3SSE,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: s3.ServerSideEncryption.CustomerKeySecretKeyRef.Name,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      batchS3SSE,
+			MountPath: batchS3SSEMountPath,
+			ReadOnly:  true,
+		})
+	}
+	if gcs != nil && gcs.ServiceAccountKeySecretKeyRef != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: batchGCSCredentials,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: gcs.ServiceAccountKeySecretKeyRef.Name,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      batchGCSCredentials,
+			MountPath: batchGCSCredentialsMountPath,
+			ReadOnly:  true,
+		})
+	}
 	return volumes, volumeMounts
 }
 
@@ -216,17 +263,20 @@ func jobS3Env(s3 *mariadbv1alpha1.S3) []v1.EnvVar {
 	if s3 == nil {
 		return nil
 	}
+	if s3.IsIAM() {
+		return nil
+	}
 	env := []v1.EnvVar{
 		{
 			Name: batchS3AccessKeyId,
 			ValueFrom: &v1.EnvVarSource{
-				SecretKeyRef: &s3.AccessKeyIdSecretKeyRef,
+				SecretKeyRef: s3.AccessKeyIdSecretKeyRef,
 			},
 		},
 		{
 			Name: batchS3SecretAccessKey,
 			ValueFrom: &v1.EnvVarSource{
-				SecretKeyRef: &s3.SecretAccessKeySecretKeyRef,
+				SecretKeyRef: s3.SecretAccessKeySecretKeyRef,
 			},
 		},
 	}
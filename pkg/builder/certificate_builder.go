@@ -0,0 +1,91 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	metadata "github.com/mariadb-operator/mariadb-operator/pkg/builder/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var CertificateGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+type CertificateOpts struct {
+	MariaDB     *mariadbv1alpha1.MariaDB
+	Key         types.NamespacedName
+	SecretName  string
+	CommonName  string
+	DNSNames    []string
+	IssuerRef   mariadbv1alpha1.CertIssuerRef
+	Duration    *time.Duration
+	RenewBefore *time.Duration
+}
+
+func (b *Builder) BuildCertificate(opts CertificateOpts, owner metav1.Object) (*unstructured.Unstructured, error) {
+	if opts.SecretName == "" {
+		return nil, errors.New("Certificate Secret name must be set")
+	}
+	if opts.IssuerRef.Name == "" {
+		return nil, errors.New("Certificate issuer name must be set")
+	}
+	objMeta :=
+		metadata.NewMetadataBuilder(opts.Key).
+			WithMariaDB(opts.MariaDB).
+			Build()
+
+	issuerRef := map[string]interface{}{
+		"name":  opts.IssuerRef.Name,
+		"kind":  "Issuer",
+		"group": CertificateGVK.Group,
+	}
+	if opts.IssuerRef.Kind != "" {
+		issuerRef["kind"] = opts.IssuerRef.Kind
+	}
+	if opts.IssuerRef.Group != "" {
+		issuerRef["group"] = opts.IssuerRef.Group
+	}
+	dnsNames := make([]interface{}, len(opts.DNSNames))
+	for i, name := range opts.DNSNames {
+		dnsNames[i] = name
+	}
+	spec := map[string]interface{}{
+		"secretName": opts.SecretName,
+		"commonName": opts.CommonName,
+		"dnsNames":   dnsNames,
+		"issuerRef":  issuerRef,
+		"usages": []interface{}{
+			"server auth",
+			"client auth",
+		},
+	}
+	if opts.Duration != nil {
+		spec["duration"] = opts.Duration.String()
+	}
+	if opts.RenewBefore != nil {
+		spec["renewBefore"] = opts.RenewBefore.String()
+	}
+
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(CertificateGVK)
+	cert.SetName(objMeta.Name)
+	cert.SetNamespace(objMeta.Namespace)
+	cert.SetLabels(objMeta.Labels)
+	cert.SetAnnotations(objMeta.Annotations)
+	if err := unstructured.SetNestedField(cert.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("error setting Certificate spec: %v", err)
+	}
+	if err := controllerutil.SetControllerReference(owner, cert, b.scheme); err != nil {
+		return nil, fmt.Errorf("error setting controller reference to Certificate: %v", err)
+	}
+	return cert, nil
+}
@@ -43,6 +43,15 @@ func (b *Builder) BuildMaxScale(key types.NamespacedName, mdb *mariadbv1alpha1.M
 			RequeueInterval:     mdbmxs.RequeueInterval,
 		},
 	}
+	if mdbmxs.TLS != nil {
+		tls := mdbmxs.TLS.DeepCopy()
+		if tls.Enabled && tls.ServerCASecretKeyRef == nil && mdb.IsTLSEnabled() {
+			serverCASecretKeyRef := mdb.TLSServerCASecretKeyRef()
+			tls.ServerCASecretKeyRef = &serverCASecretKeyRef
+		}
+		tls.SetDefaults()
+		mxs.Spec.TLS = tls
+	}
 	if err := controllerutil.SetControllerReference(mdb, &mxs, b.scheme); err != nil {
 		return nil, fmt.Errorf("error setting controller to MaxScale %v", err)
 	}
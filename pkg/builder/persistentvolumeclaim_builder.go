@@ -7,6 +7,7 @@ import (
 	metadata "github.com/mariadb-operator/mariadb-operator/pkg/builder/metadata"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 )
 
 func (b *Builder) BuildBackupPVC(key types.NamespacedName, storage *mariadbv1alpha1.BackupStorage,
@@ -23,3 +24,30 @@ func (b *Builder) BuildBackupPVC(key types.NamespacedName, storage *mariadbv1alp
 		Spec:       *storage.PersistentVolumeClaim,
 	}, nil
 }
+
+// BuildStoragePVC builds a storage PVC of the MariaDB in advance of the StatefulSet. When bootstrapping from a VolumeSnapshot,
+// it is provisioned using the VolumeSnapshot as data source.
+func (b *Builder) BuildStoragePVC(key types.NamespacedName, mariadb *mariadbv1alpha1.MariaDB) (*v1.PersistentVolumeClaim, error) {
+	if mariadb.IsEphemeralStorageEnabled() {
+		return nil, fmt.Errorf("MariaDB spec does not have a PVC spec")
+	}
+	vctpl := mariadb.Spec.VolumeClaimTemplate
+	objMeta :=
+		metadata.NewMetadataBuilder(key).
+			WithMariaDB(mariadb).
+			WithLabels(vctpl.Labels).
+			WithAnnotations(vctpl.Annotations).
+			Build()
+	spec := vctpl.PersistentVolumeClaimSpec
+	if mariadb.Spec.BootstrapFrom != nil && mariadb.Spec.BootstrapFrom.IsVolumeSnapshot() {
+		spec.DataSource = &v1.TypedLocalObjectReference{
+			APIGroup: ptr.To(VolumeSnapshotGVK.Group),
+			Kind:     VolumeSnapshotGVK.Kind,
+			Name:     mariadb.Spec.BootstrapFrom.VolumeSnapshotRef.Name,
+		}
+	}
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: objMeta,
+		Spec:       spec,
+	}, nil
+}
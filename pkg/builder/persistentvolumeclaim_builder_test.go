@@ -0,0 +1,105 @@
+package builder
+
+import (
+	"testing"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBuildStoragePVC(t *testing.T) {
+	builder := newTestBuilder(t)
+	newMariaDB := func(bootstrapFrom *mariadbv1alpha1.RestoreSource) *mariadbv1alpha1.MariaDB {
+		mariadb := newTestMariaDB()
+		mariadb.Spec.VolumeClaimTemplate = mariadbv1alpha1.VolumeClaimTemplate{
+			PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+				AccessModes: []corev1.PersistentVolumeAccessMode{
+					corev1.ReadWriteOnce,
+				},
+			},
+		}
+		mariadb.Spec.BootstrapFrom = bootstrapFrom
+		return mariadb
+	}
+
+	tests := []struct {
+		name           string
+		mariadb        *mariadbv1alpha1.MariaDB
+		wantDataSource *corev1.TypedLocalObjectReference
+	}{
+		{
+			name:           "no bootstrap",
+			mariadb:        newMariaDB(nil),
+			wantDataSource: nil,
+		},
+		{
+			name: "physical bootstrap",
+			mariadb: newMariaDB(&mariadbv1alpha1.RestoreSource{
+				BackupRef: &corev1.LocalObjectReference{
+					Name: "backup",
+				},
+				Method: mariadbv1alpha1.BackupMethodPhysical,
+			}),
+			wantDataSource: nil,
+		},
+		{
+			name: "VolumeSnapshot bootstrap",
+			mariadb: newMariaDB(&mariadbv1alpha1.RestoreSource{
+				VolumeSnapshotRef: &corev1.LocalObjectReference{
+					Name: "backup-volume-snapshot-20240101000000",
+				},
+			}),
+			wantDataSource: &corev1.TypedLocalObjectReference{
+				APIGroup: &VolumeSnapshotGVK.Group,
+				Kind:     "VolumeSnapshot",
+				Name:     "backup-volume-snapshot-20240101000000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvc, err := builder.BuildStoragePVC(tt.mariadb.StoragePVCKey(0), tt.mariadb)
+			if err != nil {
+				t.Fatalf("unexpected error building PVC: %v", err)
+			}
+			assertDataSource(t, pvc.Spec.DataSource, tt.wantDataSource)
+
+			sts, err := builder.BuildMariadbStatefulSet(tt.mariadb, types.NamespacedName{
+				Name:      tt.mariadb.Name,
+				Namespace: tt.mariadb.Namespace,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error building StatefulSet: %v", err)
+			}
+			for _, vct := range sts.Spec.VolumeClaimTemplates {
+				if vct.Spec.DataSource != nil {
+					t.Fatalf("expecting volumeClaimTemplate %s not to have a data source, got: %v", vct.Name, vct.Spec.DataSource)
+				}
+			}
+		})
+	}
+}
+
+func assertDataSource(t *testing.T, dataSource, wantDataSource *corev1.TypedLocalObjectReference) {
+	if wantDataSource == nil {
+		if dataSource != nil {
+			t.Fatalf("expecting PVC not to have a data source, got: %v", dataSource)
+		}
+		return
+	}
+	if dataSource == nil {
+		t.Fatalf("expecting PVC to have data source %v, got none", wantDataSource)
+	}
+	if *dataSource.APIGroup != *wantDataSource.APIGroup || dataSource.Kind != wantDataSource.Kind ||
+		dataSource.Name != wantDataSource.Name {
+		t.Fatalf("unexpected data source, expected: %v got: %v", wantDataSource, dataSource)
+	}
+}
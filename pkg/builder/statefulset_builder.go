@@ -29,6 +29,12 @@ const (
 	ProbesVolume    = "probes"
 	ProbesMountPath = "/etc/probes"
 
+	TLSVolume           = "tls"
+	MariadbTLSMountPath = "/etc/pki/mariadb"
+	MariadbTLSCAPath    = MariadbTLSMountPath + "/ca.crt"
+	MariadbTLSCertPath  = MariadbTLSMountPath + "/server.crt"
+	MariadbTLSKeyPath   = MariadbTLSMountPath + "/server.key"
+
 	ServiceAccountVolume    = "serviceaccount"
 	ServiceAccountMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
 
@@ -77,7 +83,8 @@ func (b *Builder) BuildMariadbStatefulSet(mariadb *mariadbv1alpha1.MariaDB, key
 	return sts, nil
 }
 
-func (b *Builder) BuildMaxscaleStatefulSet(maxscale *mariadbv1alpha1.MaxScale, key types.NamespacedName) (*appsv1.StatefulSet, error) {
+func (b *Builder) BuildMaxscaleStatefulSet(maxscale *mariadbv1alpha1.MaxScale, key types.NamespacedName,
+	podAnnotations map[string]string) (*appsv1.StatefulSet, error) {
 	objMeta :=
 		metadata.NewMetadataBuilder(key).
 			Build()
@@ -85,7 +92,7 @@ func (b *Builder) BuildMaxscaleStatefulSet(maxscale *mariadbv1alpha1.MaxScale, k
 		labels.NewLabelsBuilder().
 			WithMaxScaleSelectorLabels(maxscale).
 			Build()
-	podTemplate, err := b.maxscalePodTemplate(maxscale, selectorLabels)
+	podTemplate, err := b.maxscalePodTemplate(maxscale, selectorLabels, podAnnotations)
 	if err != nil {
 		return nil, fmt.Errorf("error building pod template: %v", err)
 	}
@@ -141,7 +148,8 @@ func (b *Builder) mariadbPodTemplate(mariadb *mariadbv1alpha1.MariaDB, labels ma
 	}, nil
 }
 
-func (b *Builder) maxscalePodTemplate(mxs *mariadbv1alpha1.MaxScale, labels map[string]string) (*corev1.PodTemplateSpec, error) {
+func (b *Builder) maxscalePodTemplate(mxs *mariadbv1alpha1.MaxScale, labels map[string]string,
+	annotations map[string]string) (*corev1.PodTemplateSpec, error) {
 	containers, err := b.maxscaleContainers(mxs)
 	if err != nil {
 		return nil, fmt.Errorf("error building MaxScale containers: %v", err)
@@ -149,6 +157,7 @@ func (b *Builder) maxscalePodTemplate(mxs *mariadbv1alpha1.MaxScale, labels map[
 	objMeta :=
 		metadata.NewMetadataBuilder(client.ObjectKeyFromObject(mxs)).
 			WithLabels(labels).
+			WithAnnotations(annotations).
 			Build()
 	return &corev1.PodTemplateSpec{
 		ObjectMeta: objMeta,
@@ -305,6 +314,26 @@ func mariadbVolumes(mariadb *mariadbv1alpha1.MariaDB) []corev1.Volume {
 			},
 		})
 	}
+	if mariadb.IsTLSEnabled() {
+		volumes = append(volumes, mariadbTLSVolume(mariadb))
+	}
+	if mariadb.IsGaleraTLSEnabled() {
+		configMapKeyRef := mariadb.GaleraConfigMapKeyRef()
+		volumes = append(volumes, corev1.Volume{
+			Name: galeraresources.GaleraTLSConfigVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: configMapKeyRef.LocalObjectReference,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  configMapKeyRef.Key,
+							Path: galeraresources.GaleraTLSConfigFileName,
+						},
+					},
+				},
+			},
+		})
+	}
 	if mariadb.IsEphemeralStorageEnabled() {
 		volumes = append(volumes, corev1.Volume{
 			Name: StorageVolume,
@@ -319,6 +348,46 @@ func mariadbVolumes(mariadb *mariadbv1alpha1.MariaDB) []corev1.Volume {
 	return volumes
 }
 
+func mariadbTLSVolume(mariadb *mariadbv1alpha1.MariaDB) corev1.Volume {
+	caSecretKeyRef := mariadb.TLSServerCASecretKeyRef()
+	certSecretRef := mariadb.TLSServerCertSecretRef()
+	return corev1.Volume{
+		Name: TLSVolume,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: caSecretKeyRef.LocalObjectReference,
+							Items: []corev1.KeyToPath{
+								{
+									Key:  caSecretKeyRef.Key,
+									Path: "ca.crt",
+								},
+							},
+						},
+					},
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: certSecretRef,
+							Items: []corev1.KeyToPath{
+								{
+									Key:  corev1.TLSCertKey,
+									Path: "server.crt",
+								},
+								{
+									Key:  corev1.TLSPrivateKeyKey,
+									Path: "server.key",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func maxscaleVolumes(maxscale *mariadbv1alpha1.MaxScale) []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
@@ -330,12 +399,70 @@ func maxscaleVolumes(maxscale *mariadbv1alpha1.MaxScale) []corev1.Volume {
 			},
 		},
 	}
+	if maxscale.IsTLSEnabled() {
+		volumes = append(volumes, maxscaleTLSVolume(maxscale))
+	}
 	if maxscale.Spec.Volumes != nil {
 		volumes = append(volumes, maxscale.Spec.Volumes...)
 	}
 	return volumes
 }
 
+func maxscaleTLSVolume(maxscale *mariadbv1alpha1.MaxScale) corev1.Volume {
+	caSecretKeyRef := maxscale.TLSCASecretKeyRef()
+	certSecretRef := maxscale.TLSCertSecretRef()
+	sources := []corev1.VolumeProjection{
+		{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: caSecretKeyRef.LocalObjectReference,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  caSecretKeyRef.Key,
+						Path: "ca.crt",
+					},
+				},
+			},
+		},
+		{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: certSecretRef,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  corev1.TLSCertKey,
+						Path: "server.crt",
+					},
+					{
+						Key:  corev1.TLSPrivateKeyKey,
+						Path: "server.key",
+					},
+				},
+			},
+		},
+	}
+	if maxscale.IsServerTLSEnabled() {
+		serverCASecretKeyRef := maxscale.Spec.TLS.ServerCASecretKeyRef
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: serverCASecretKeyRef.LocalObjectReference,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  serverCASecretKeyRef.Key,
+						Path: "mariadb-ca.crt",
+					},
+				},
+			},
+		})
+	}
+	return corev1.Volume{
+		Name: TLSVolume,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: sources,
+			},
+		},
+	}
+}
+
 func mariadbHAAnnotations(mariadb *mariadbv1alpha1.MariaDB) map[string]string {
 	var annotations map[string]string
 	if mariadb.IsHAEnabled() {
@@ -7,6 +7,7 @@ import (
 
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	galeraresources "github.com/mariadb-operator/mariadb-operator/pkg/controller/galera/resources"
+	maxscaleresources "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/resources"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -19,6 +20,14 @@ func (b *Builder) mariadbContainers(mariadb *mariadbv1alpha1.MariaDB) ([]corev1.
 	mariadbContainer.Env = mariadbEnv(mariadb)
 	mariadbContainer.Ports = mariadbPorts(mariadb)
 	mariadbContainer.VolumeMounts = mariadbVolumeMounts(mariadb)
+	if mariadb.IsGaleraTLSEnabled() {
+		mariadbContainer.VolumeMounts = append(mariadbContainer.VolumeMounts, corev1.VolumeMount{
+			Name:      galeraresources.GaleraTLSConfigVolume,
+			MountPath: fmt.Sprintf("%s/%s", galeraresources.GaleraConfigMountPath, galeraresources.GaleraTLSConfigFileName),
+			SubPath:   galeraresources.GaleraTLSConfigFileName,
+			ReadOnly:  true,
+		})
+	}
 	mariadbContainer.LivenessProbe = mariadbLivenessProbe(mariadb)
 	mariadbContainer.ReadinessProbe = mariadbReadinessProbe(mariadb)
 
@@ -179,13 +188,24 @@ func galeraInitContainer(mariadb *mariadbv1alpha1.MariaDB) corev1.Container {
 }
 
 func mariadbArgs(mariadb *mariadbv1alpha1.MariaDB) []string {
+	var args []string
 	if mariadb.Replication().Enabled {
-		return []string{
+		args = append(args, []string{
 			"--log-bin",
 			fmt.Sprintf("--log-basename=%s", mariadb.Name),
+		}...)
+	}
+	if mariadb.IsTLSEnabled() {
+		args = append(args, []string{
+			fmt.Sprintf("--ssl-ca=%s", MariadbTLSCAPath),
+			fmt.Sprintf("--ssl-cert=%s", MariadbTLSCertPath),
+			fmt.Sprintf("--ssl-key=%s", MariadbTLSKeyPath),
+		}...)
+		if mariadb.IsSecureTransportRequired() {
+			args = append(args, "--require-secure-transport=ON")
 		}
 	}
-	return nil
+	return args
 }
 
 func mariadbEnv(mariadb *mariadbv1alpha1.MariaDB) []corev1.EnvVar {
@@ -282,6 +302,13 @@ func mariadbVolumeMounts(mariadb *mariadbv1alpha1.MariaDB) []corev1.VolumeMount
 			MountPath: ProbesMountPath,
 		})
 	}
+	if mariadb.IsTLSEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      TLSVolume,
+			MountPath: MariadbTLSMountPath,
+			ReadOnly:  true,
+		})
+	}
 	if mariadb.Galera().Enabled {
 		volumeMounts = append(volumeMounts, []corev1.VolumeMount{
 			{
@@ -311,6 +338,13 @@ func maxscaleVolumeMounts(maxscale *mariadbv1alpha1.MaxScale) []corev1.VolumeMou
 			MountPath: MaxscaleConfigMountPath,
 		},
 	}
+	if maxscale.IsTLSEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      TLSVolume,
+			MountPath: maxscaleresources.TLSMountPath,
+			ReadOnly:  true,
+		})
+	}
 	if maxscale.Spec.VolumeMounts != nil {
 		volumeMounts = append(volumeMounts, maxscale.Spec.VolumeMounts...)
 	}
@@ -410,11 +444,16 @@ func maxscaleProbe(mxs *mariadbv1alpha1.MaxScale, probe *corev1.Probe) *corev1.P
 	if probe != nil {
 		return probe
 	}
+	scheme := corev1.URISchemeHTTP
+	if mxs.IsTLSEnabled() {
+		scheme = corev1.URISchemeHTTPS
+	}
 	return &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
 			HTTPGet: &corev1.HTTPGetAction{
-				Path: "/",
-				Port: intstr.FromInt(int(mxs.Spec.Admin.Port)),
+				Path:   "/",
+				Port:   intstr.FromInt(int(mxs.Spec.Admin.Port)),
+				Scheme: scheme,
 			},
 		},
 		InitialDelaySeconds: 20,
@@ -0,0 +1,60 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	metadata "github.com/mariadb-operator/mariadb-operator/pkg/builder/metadata"
+	annotation "github.com/mariadb-operator/mariadb-operator/pkg/metadata"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var VolumeSnapshotGVK = schema.GroupVersionKind{
+	Group:   "snapshot.storage.k8s.io",
+	Version: "v1",
+	Kind:    "VolumeSnapshot",
+}
+
+// BuildVolumeSnapshot builds a VolumeSnapshot of the given storage PVC. It is labeled with the Backup name
+// to be able to list the VolumeSnapshots of a Backup. A controller reference is not set on purpose,
+// so the VolumeSnapshots outlive the Backup.
+func (b *Builder) BuildVolumeSnapshot(key types.NamespacedName, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB, pvcName string, snapshotTime time.Time) (*unstructured.Unstructured, error) {
+	if pvcName == "" {
+		return nil, errors.New("VolumeSnapshot PVC name must be set")
+	}
+	objMeta :=
+		metadata.NewMetadataBuilder(key).
+			WithMariaDB(mariadb).
+			WithLabels(map[string]string{
+				annotation.BackupLabel: backup.Name,
+			}).
+			WithAnnotations(map[string]string{
+				annotation.BackupTimeAnnotation: snapshotTime.UTC().Format(time.RFC3339),
+			}).
+			Build()
+
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvcName,
+		},
+	}
+	if backup.Spec.VolumeSnapshot != nil && backup.Spec.VolumeSnapshot.VolumeSnapshotClassName != nil {
+		spec["volumeSnapshotClassName"] = *backup.Spec.VolumeSnapshot.VolumeSnapshotClassName
+	}
+
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetGroupVersionKind(VolumeSnapshotGVK)
+	snapshot.SetName(objMeta.Name)
+	snapshot.SetNamespace(objMeta.Namespace)
+	snapshot.SetLabels(objMeta.Labels)
+	snapshot.SetAnnotations(objMeta.Annotations)
+	if err := unstructured.SetNestedField(snapshot.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("error setting VolumeSnapshot spec: %v", err)
+	}
+	return snapshot, nil
+}
@@ -3,6 +3,8 @@ package command
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,21 +12,78 @@ import (
 	backuppkg "github.com/mariadb-operator/mariadb-operator/pkg/backup"
 )
 
+// gtidPipe extracts the GTID position from the comments written by mariadb-dump when using --gtid along with --master-data.
+const gtidPipe = " | grep -m 1 'gtid_slave_pos=' | sed \"s/.*gtid_slave_pos='\\([^']*\\)'.*/\\1/\""
+
+var (
+	compressCmds = map[mariadbv1alpha1.CompressAlgorithm]string{
+		mariadbv1alpha1.CompressGzip:  "gzip -c",
+		mariadbv1alpha1.CompressZstd:  "zstd -c -q",
+		mariadbv1alpha1.CompressBzip2: "bzip2 -c",
+	}
+	decompressCmds = map[mariadbv1alpha1.CompressAlgorithm]string{
+		mariadbv1alpha1.CompressGzip:  "gzip -dc",
+		mariadbv1alpha1.CompressZstd:  "zstd -dc -q",
+		mariadbv1alpha1.CompressBzip2: "bzip2 -dc",
+	}
+	mydumperCompressAlgorithms = map[mariadbv1alpha1.CompressAlgorithm]string{
+		mariadbv1alpha1.CompressGzip: "GZIP",
+		mariadbv1alpha1.CompressZstd: "ZSTD",
+	}
+)
+
 type BackupOpts struct {
 	CommandOpts
-	Path                 string
-	TargetFilePath       string
-	MaxRetentionDuration time.Duration
-	TargetTime           time.Time
-	S3                   bool
-	S3Bucket             string
-	S3Endpoint           string
-	S3Region             string
-	S3TLS                bool
-	S3CACertPath         string
-	S3Prefix             string
-	LogLevel             string
-	DumpOpts             []string
+	Path                  string
+	TargetFilePath        string
+	MaxRetentionDuration  time.Duration
+	RetentionPolicy       backuppkg.RetentionPolicy
+	CatalogPath           string
+	CatalogLimit          int
+	TargetTime            time.Time
+	S3                    bool
+	S3Bucket              string
+	S3Endpoint            string
+	S3Region              string
+	S3TLS                 bool
+	S3CACertPath          string
+	S3IAM                 bool
+	S3SSE                 string
+	S3SSEKMSKeyID         string
+	S3SSECustomerKeyPath  string
+	S3StorageClass        string
+	S3Tags                map[string]string
+	S3Prefix              string
+	S3Streaming           bool
+	AzureBlob             bool
+	AzureBlobContainer    string
+	AzureBlobAccount      string
+	AzureBlobServiceURL   string
+	AzureBlobPrefix       string
+	GCS                   bool
+	GCSBucket             string
+	GCSPrefix             string
+	GCSCredentialsPath    string
+	LogLevel              string
+	DumpOpts              []string
+	Physical              bool
+	DatadirPath           string
+	StagingPath           string
+	ReplayBinlogs         bool
+	Compression           mariadbv1alpha1.CompressAlgorithm
+	EncryptionKeyPath     string
+	DecryptPath           string
+	VerifyDatadirPath     string
+	VerifySqlQueryEnv     string
+	TargetPodIndex        *int
+	DumpDatabases         []string
+	DumpTables            []string
+	RestoreDatabase       string
+	RestoreTargetDatabase string
+	MydumperEngine        bool
+	MydumperThreads       int32
+	MydumperRows          int64
+	MydumperChunkFilesize int32
 }
 
 type BackupOpt func(*BackupOpts)
@@ -36,12 +95,105 @@ func WithBackup(path string, targetFilePath string) BackupOpt {
 	}
 }
 
+func WithBackupPhysical(datadirPath, stagingPath string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.Physical = true
+		bo.DatadirPath = datadirPath
+		bo.StagingPath = stagingPath
+	}
+}
+
+func WithBackupReplayBinlogs(replay bool) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.ReplayBinlogs = replay
+	}
+}
+
+func WithBackupCompression(c mariadbv1alpha1.CompressAlgorithm) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.Compression = c
+	}
+}
+
+func WithBackupEncryption(keyPath string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.EncryptionKeyPath = keyPath
+	}
+}
+
+func WithBackupDecryption(keyPath, decryptPath string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.EncryptionKeyPath = keyPath
+		bo.DecryptPath = decryptPath
+	}
+}
+
 func WithBackupMaxRetention(d time.Duration) BackupOpt {
 	return func(bo *BackupOpts) {
 		bo.MaxRetentionDuration = d
 	}
 }
 
+func WithBackupRetentionPolicy(policy *mariadbv1alpha1.BackupRetentionPolicy) BackupOpt {
+	return func(bo *BackupOpts) {
+		if policy == nil {
+			return
+		}
+		bo.RetentionPolicy = backuppkg.RetentionPolicy{
+			Hourly:  int(policy.KeepHourly),
+			Daily:   int(policy.KeepDaily),
+			Weekly:  int(policy.KeepWeekly),
+			Monthly: int(policy.KeepMonthly),
+			Yearly:  int(policy.KeepYearly),
+		}
+	}
+}
+
+func WithBackupCatalog(path string, limit int) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.CatalogPath = path
+		bo.CatalogLimit = limit
+	}
+}
+
+func WithBackupVerify(datadirPath, sqlQueryEnv string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.VerifyDatadirPath = datadirPath
+		bo.VerifySqlQueryEnv = sqlQueryEnv
+	}
+}
+
+func WithBackupTargetPodIndex(podIndex int) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.TargetPodIndex = &podIndex
+	}
+}
+
+func WithBackupDatabases(databases, tables []string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.DumpDatabases = databases
+		bo.DumpTables = tables
+	}
+}
+
+func WithBackupRestoreDatabase(database, targetDatabase string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.RestoreDatabase = database
+		bo.RestoreTargetDatabase = targetDatabase
+	}
+}
+
+func WithBackupMydumper(mydumper *mariadbv1alpha1.Mydumper) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.MydumperEngine = true
+		bo.MydumperThreads = mydumper.ThreadsOrDefault()
+		if mydumper != nil {
+			bo.MydumperRows = mydumper.Rows
+			bo.MydumperChunkFilesize = mydumper.ChunkFilesize
+		}
+	}
+}
+
 func WithBackupTargetTime(t time.Time) BackupOpt {
 	return func(bo *BackupOpts) {
 		bo.TargetTime = t
@@ -65,6 +217,57 @@ func WithS3TLS(caCertPath string) BackupOpt {
 	}
 }
 
+func WithS3IAM() BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.S3IAM = true
+	}
+}
+
+func WithS3SSE(sseType, kmsKeyID, customerKeyPath string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.S3SSE = sseType
+		bo.S3SSEKMSKeyID = kmsKeyID
+		bo.S3SSECustomerKeyPath = customerKeyPath
+	}
+}
+
+func WithS3StorageClass(storageClass string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.S3StorageClass = storageClass
+	}
+}
+
+func WithS3Tags(tags map[string]string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.S3Tags = tags
+	}
+}
+
+func WithS3Streaming() BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.S3Streaming = true
+	}
+}
+
+func WithAzureBlob(container, storageAccount, serviceURL, prefix string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.AzureBlob = true
+		bo.AzureBlobContainer = container
+		bo.AzureBlobAccount = storageAccount
+		bo.AzureBlobServiceURL = serviceURL
+		bo.AzureBlobPrefix = prefix
+	}
+}
+
+func WithGCS(bucket, prefix, credentialsPath string) BackupOpt {
+	return func(bo *BackupOpts) {
+		bo.GCS = true
+		bo.GCSBucket = bucket
+		bo.GCSPrefix = prefix
+		bo.GCSCredentialsPath = credentialsPath
+	}
+}
+
 func WithBackupDumpOpts(opts []string) BackupOpt {
 	return func(o *BackupOpts) {
 		o.DumpOpts = opts
@@ -122,147 +325,1154 @@ func NewBackupCommand(userOpts ...BackupOpt) (*BackupCommand, error) {
 	if opts.PasswordEnv == "" {
 		return nil, errors.New("password environment variable not provided")
 	}
+	if opts.Physical && (opts.DatadirPath == "" || opts.StagingPath == "") {
+		return nil, errors.New("datadir and staging paths are mandatory for physical backups")
+	}
 	return &BackupCommand{opts}, nil
 }
 
 func (b *BackupCommand) MariadbDump(backup *mariadbv1alpha1.Backup,
 	mariadb *mariadbv1alpha1.MariaDB) *Command {
-	dumpOpts := "--single-transaction --events --routines --dump-slave=2 --master-data=2 --gtid --all-databases"
-	if b.BackupOpts.DumpOpts != nil {
-		dumpOpts = strings.Join(b.BackupOpts.DumpOpts, " ")
+	selections := b.databaseSelections()
+	dumpOpts := b.backupDumpOpts()
+	connectionFlags := PrimaryConnectionFlags(&b.BackupOpts.CommandOpts, mariadb)
+	if b.TargetPodIndex != nil {
+		connectionFlags = PodConnectionFlags(&b.BackupOpts.CommandOpts, mariadb, *b.TargetPodIndex)
 	}
+
 	cmds := []string{
 		"set -euo pipefail",
+	}
+	if b.TargetPodIndex != nil {
+		cmds = append(cmds, b.replicaTargetCmds(mariadb, connectionFlags)...)
+	}
+	if b.S3Streaming {
+		cmds = append(cmds, b.streamingDumpCmds(connectionFlags, dumpOpts)...)
+		return NewBashCommand(cmds)
+	}
+	if len(selections) > 0 {
+		cmds = append(cmds, b.databaseDumpCmds(selections, connectionFlags, dumpOpts)...)
+	} else {
+		cmds = append(cmds,
+			"echo 💾 Exporting env",
+			fmt.Sprintf(
+				"export BACKUP_FILE=%s",
+				b.newBackupFile(),
+			),
+			fmt.Sprintf(
+				"echo 💾 Writing target file: %s",
+				b.TargetFilePath,
+			),
+			fmt.Sprintf(
+				"printf \"${BACKUP_FILE}\" > %s",
+				b.TargetFilePath,
+			),
+			fmt.Sprintf(
+				"echo 💾 Taking backup: %s",
+				b.getTargetFilePath(),
+			),
+			fmt.Sprintf(
+				"mariadb-dump %s %s%s > %s",
+				connectionFlags,
+				dumpOpts,
+				b.compressPipe(),
+				b.getTargetFilePath(),
+			),
+		)
+	}
+	cmds = append(cmds,
+		"echo 💾 Setting target file permissions",
+		fmt.Sprintf(
+			"chmod 777 %s",
+			b.TargetFilePath,
+		),
+		"echo 💾 Getting GTID from backup",
+		fmt.Sprintf(
+			"(%s %s/$(head -n 1 '%s')%s || true) > %s",
+			b.readCmd(),
+			b.Path,
+			b.TargetFilePath,
+			gtidPipe,
+			b.getGtidFilePath(),
+		),
+	)
+	cmds = append(cmds, b.serverVersionCmds(connectionFlags)...)
+	return NewBashCommand(cmds)
+}
+
+// streamingDumpCmds pipes the backup into a stream, which is read by the mariadb-operator container to upload it to S3.
+// The stream is completed once the backup has been completely written, otherwise the upload is aborted.
+func (b *BackupCommand) streamingDumpCmds(connectionFlags, dumpOpts string) []string {
+	cmds := []string{
 		"echo 💾 Exporting env",
 		fmt.Sprintf(
 			"export BACKUP_FILE=%s",
 			b.newBackupFile(),
 		),
+	}
+	cmds = append(cmds, b.serverVersionCmds(connectionFlags)...)
+	return append(cmds,
 		fmt.Sprintf(
 			"echo 💾 Writing target file: %s",
 			b.TargetFilePath,
 		),
+		fmt.Sprintf(
+			"rm -f %s",
+			b.getStreamCompletePath(),
+		),
 		fmt.Sprintf(
 			"printf \"${BACKUP_FILE}\" > %s",
 			b.TargetFilePath,
 		),
-		"echo 💾 Setting target file permissions",
 		fmt.Sprintf(
 			"chmod 777 %s",
 			b.TargetFilePath,
 		),
 		fmt.Sprintf(
-			"echo 💾 Taking backup: %s",
-			b.getTargetFilePath(),
+			"echo 💾 Creating backup stream: %s",
+			b.getStreamPath(),
 		),
 		fmt.Sprintf(
-			"mariadb-dump %s %s > %s",
-			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+			"rm -f %s && mkfifo %s",
+			b.getStreamPath(),
+			b.getStreamPath(),
+		),
+		"echo 💾 Streaming backup: ${BACKUP_FILE}",
+		fmt.Sprintf(
+			"mariadb-dump %s %s%s > %s",
+			connectionFlags,
 			dumpOpts,
-			b.getTargetFilePath(),
+			b.compressPipe(),
+			b.getStreamPath(),
 		),
-	}
-	return NewBashCommand(cmds)
+		"echo 💾 Completing backup stream",
+		fmt.Sprintf(
+			"touch %s",
+			b.getStreamCompletePath(),
+		),
+	)
 }
 
-func (b *BackupCommand) MariadbOperatorBackup() *Command {
-	args := []string{
-		"backup",
-		"--path",
-		b.Path,
-		"--target-file-path",
-		b.TargetFilePath,
-		"--max-retention",
-		b.MaxRetentionDuration.String(),
-		"--log-level",
-		b.LogLevel,
+// databaseSelection is a database to be backed up, optionally limited to some of its tables.
+type databaseSelection struct {
+	database string
+	tables   []string
+}
+
+// databaseSelections groups the databases and tables to be backed up by database, keeping the order in which they were defined.
+// Tables are defined as "<database>.<table>", and a database with tables defined only backs up those tables.
+func (b *BackupCommand) databaseSelections() []databaseSelection {
+	var selections []databaseSelection
+	indexes := make(map[string]int)
+	add := func(database string) int {
+		if i, ok := indexes[database]; ok {
+			return i
+		}
+		selections = append(selections, databaseSelection{database: database})
+		indexes[database] = len(selections) - 1
+		return indexes[database]
 	}
-	args = append(args, b.s3Args()...)
-	return NewCommand(nil, args)
+	for _, database := range b.DumpDatabases {
+		add(database)
+	}
+	for _, table := range b.DumpTables {
+		database, tableName, ok := strings.Cut(table, ".")
+		if !ok {
+			continue
+		}
+		i := add(database)
+		selections[i].tables = append(selections[i].tables, tableName)
+	}
+	return selections
 }
 
-func (b *BackupCommand) MariadbOperatorRestore() *Command {
-	args := []string{
-		"backup",
-		"restore",
-		"--path",
-		b.Path,
-		"--target-time",
-		backuppkg.FormatBackupDate(b.TargetTime),
-		"--target-file-path",
-		b.TargetFilePath,
-		"--log-level",
-		b.LogLevel,
+// databaseDumpCmds takes a backup file per database, all of them sharing the same date. The target file lists a backup file per line.
+// Every backup file creates and selects its database, so it can be restored independently.
+func (b *BackupCommand) databaseDumpCmds(selections []databaseSelection, connectionFlags, dumpOpts string) []string {
+	cmds := []string{
+		"echo 💾 Exporting env",
+		"export BACKUP_DATE=$(date -u +'%Y-%m-%dT%H:%M:%SZ')",
+		fmt.Sprintf(
+			"echo 💾 Writing target file: %s",
+			b.TargetFilePath,
+		),
+		fmt.Sprintf(
+			": > %s",
+			b.TargetFilePath,
+		),
 	}
-	args = append(args, b.s3Args()...)
-	return NewCommand(nil, args)
+	for _, selection := range selections {
+		dumpArgs := []string{shellQuote(selection.database)}
+		for _, table := range selection.tables {
+			dumpArgs = append(dumpArgs, shellQuote(table))
+		}
+		cmds = append(cmds,
+			fmt.Sprintf(
+				"export BACKUP_FILE=backup.${BACKUP_DATE}.%s.%s",
+				shellQuote(selection.database),
+				b.backupFileExtension(),
+			),
+			fmt.Sprintf(
+				"echo 💾 Taking backup: %s/${BACKUP_FILE}",
+				b.Path,
+			),
+			fmt.Sprintf(
+				"(echo %s && mariadb-dump %s %s %s)%s > %s/${BACKUP_FILE}",
+				shellQuote(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`; USE `%s`;", selection.database, selection.database)),
+				connectionFlags,
+				dumpOpts,
+				strings.Join(dumpArgs, " "),
+				b.compressPipe(),
+				b.Path,
+			),
+			fmt.Sprintf(
+				"echo \"${BACKUP_FILE}\" >> %s",
+				b.TargetFilePath,
+			),
+		)
+	}
+	return cmds
 }
 
-func (b *BackupCommand) MariadbRestore(mariadb *mariadbv1alpha1.MariaDB) *Command {
-	dumpOpts := ""
-	if b.BackupOpts.DumpOpts != nil {
-		dumpOpts = strings.Join(b.BackupOpts.DumpOpts, " ")
+// Mydumper dumps the tables in parallel into a directory, which is archived as a tarball in the backup path.
+func (b *BackupCommand) Mydumper(mariadb *mariadbv1alpha1.MariaDB) *Command {
+	dumpOpts := b.backupDumpOpts()
+	connectionFlags := PrimaryConnectionFlags(&b.BackupOpts.CommandOpts, mariadb)
+	if b.TargetPodIndex != nil {
+		connectionFlags = PodConnectionFlags(&b.BackupOpts.CommandOpts, mariadb, *b.TargetPodIndex)
 	}
+
 	cmds := []string{
 		"set -euo pipefail",
+		"echo 💾 Exporting env",
 		fmt.Sprintf(
-			"echo 💾 Restoring backup: %s",
+			"export BACKUP_FILE=%s",
+			b.newBackupFile(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Writing target file: %s",
+			b.TargetFilePath,
+		),
+		fmt.Sprintf(
+			"printf \"${BACKUP_FILE}\" > %s",
+			b.TargetFilePath,
+		),
+		"echo 💾 Setting target file permissions",
+		fmt.Sprintf(
+			"chmod 777 %s",
+			b.TargetFilePath,
+		),
+	}
+	if b.TargetPodIndex != nil {
+		cmds = append(cmds, b.replicaTargetCmds(mariadb, connectionFlags)...)
+	}
+	cmds = append(cmds,
+		"echo 💾 Cleaning up dump directory",
+		fmt.Sprintf(
+			"rm -rf %s",
+			b.getMydumperDir(b.Path),
+		),
+		fmt.Sprintf(
+			"echo 💾 Taking backup: %s",
 			b.getTargetFilePath(),
 		),
 		fmt.Sprintf(
-			"mariadb %s %s < %s",
-			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+			"mydumper %s %s --outputdir=%s %s",
+			connectionFlags,
+			b.mydumperOpts(),
+			b.getMydumperDir(b.Path),
 			dumpOpts,
+		),
+		"echo 💾 Getting GTID from backup",
+		fmt.Sprintf(
+			"(grep -m 1 -E 'GTID:|Executed_Gtid_Set' %s/metadata | sed 's/.*[:=] *//' || true) > %s",
+			b.getMydumperDir(b.Path),
+			b.getGtidFilePath(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Archiving backup: %s",
 			b.getTargetFilePath(),
 		),
-	}
-	return NewBashCommand(cmds)
-}
-
-func (b *BackupCommand) newBackupFile() string {
-	return fmt.Sprintf(
-		"backup.$(date -u +'%s').sql",
-		"%Y-%m-%dT%H:%M:%SZ",
+		fmt.Sprintf(
+			"tar -cf %s -C %s .",
+			b.getTargetFilePath(),
+			b.getMydumperDir(b.Path),
+		),
+		fmt.Sprintf(
+			"rm -rf %s",
+			b.getMydumperDir(b.Path),
+		),
 	)
+	cmds = append(cmds, b.serverVersionCmds(connectionFlags)...)
+	return NewBashCommand(cmds)
 }
 
-func (b *BackupCommand) getTargetFilePath() string {
-	return fmt.Sprintf("%s/$(cat '%s')", b.Path, b.TargetFilePath)
+func (b *BackupCommand) mydumperOpts() string {
+	opts := []string{
+		fmt.Sprintf("--threads=%d", b.MydumperThreads),
+	}
+	if b.MydumperRows > 0 {
+		opts = append(opts, fmt.Sprintf("--rows=%d", b.MydumperRows))
+	}
+	if b.MydumperChunkFilesize > 0 {
+		opts = append(opts, fmt.Sprintf("--chunk-filesize=%d", b.MydumperChunkFilesize))
+	}
+	if algorithm, ok := mydumperCompressAlgorithms[b.Compression]; ok {
+		opts = append(opts, fmt.Sprintf("--compress=%s", algorithm))
+	}
+	return strings.Join(opts, " ")
 }
 
-func (b *BackupCommand) s3Args() []string {
-	if !b.S3 {
-		return nil
+// replicaTargetCmds prepares the replica the backup is taken from, which is restored when the script exits.
+func (b *BackupCommand) replicaTargetCmds(mariadb *mariadbv1alpha1.MariaDB, connectionFlags string) []string {
+	if mariadb.Galera().Enabled {
+		return b.galeraDesyncCmds(connectionFlags)
 	}
-	args := []string{
-		"--s3",
-		"--s3-bucket",
-		b.S3Bucket,
-		"--s3-endpoint",
-		b.S3Endpoint,
+	if mariadb.Replication().Enabled {
+		return b.replicationStopCmds(connectionFlags)
 	}
-	if b.S3Region != "" {
-		args = append(args,
-			"--s3-region",
-			b.S3Region,
-		)
+	return nil
+}
+
+// replicationStopCmds stops the SQL thread of the replica while the backup is taken, so it is not affected by
+// the replicated changes. The SQL thread is started again when the script exits.
+func (b *BackupCommand) replicationStopCmds(connectionFlags string) []string {
+	return []string{
+		"echo 💾 Stopping replica SQL thread",
+		fmt.Sprintf(
+			"mariadb %s -e 'STOP SLAVE SQL_THREAD'",
+			connectionFlags,
+		),
+		fmt.Sprintf(
+			"trap \"echo 💾 Starting replica SQL thread; mariadb %s -e 'START SLAVE SQL_THREAD'\" EXIT",
+			connectionFlags,
+		),
 	}
-	if b.S3TLS {
-		args = append(args,
-			"--s3-tls",
-		)
-		if b.S3CACertPath != "" {
-			args = append(args,
-				"--s3-ca-cert-path",
-				b.S3CACertPath,
-			)
-		}
+}
+
+// galeraDesyncCmds desyncs the Galera node while the backup is taken, so it does not apply flow control to the cluster.
+// The node is synced again when the script exits.
+func (b *BackupCommand) galeraDesyncCmds(connectionFlags string) []string {
+	return []string{
+		"echo 💾 Desyncing Galera node",
+		fmt.Sprintf(
+			"mariadb %s -e 'SET GLOBAL wsrep_desync=ON'",
+			connectionFlags,
+		),
+		fmt.Sprintf(
+			"trap \"echo 💾 Syncing Galera node; mariadb %s -e 'SET GLOBAL wsrep_desync=OFF'\" EXIT",
+			connectionFlags,
+		),
 	}
-	if b.S3Prefix != "" {
-		args = append(args,
+}
+
+func (b *BackupCommand) MariaBackup(mariadb *mariadbv1alpha1.MariaDB, podIndex int) *Command {
+	backupOpts := b.backupDumpOpts()
+	connectionFlags := PodConnectionFlags(&b.BackupOpts.CommandOpts, mariadb, podIndex)
+	cmds := []string{
+		"set -euo pipefail",
+		"echo 💾 Exporting env",
+		fmt.Sprintf(
+			"export BACKUP_FILE=%s",
+			b.newBackupFile(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Writing target file: %s",
+			b.TargetFilePath,
+		),
+		fmt.Sprintf(
+			"printf \"${BACKUP_FILE}\" > %s",
+			b.TargetFilePath,
+		),
+		"echo 💾 Setting target file permissions",
+		fmt.Sprintf(
+			"chmod 777 %s",
+			b.TargetFilePath,
+		),
+		"echo 💾 Cleaning up staging directory",
+		fmt.Sprintf(
+			"rm -rf %s",
+			b.getStagingDir(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Taking physical backup of Pod: %d",
+			podIndex,
+		),
+		fmt.Sprintf(
+			"mariabackup --backup --target-dir=%s --datadir=%s %s %s",
+			b.getStagingDir(),
+			b.DatadirPath,
+			connectionFlags,
+			backupOpts,
+		),
+		"echo 💾 Preparing physical backup",
+		fmt.Sprintf(
+			"mariabackup --prepare --target-dir=%s",
+			b.getStagingDir(),
+		),
+		"echo 💾 Getting GTID from backup",
+		fmt.Sprintf(
+			"(cat %s/*_binlog_info | awk '{print $3}' || true) > %s",
+			b.getStagingDir(),
+			b.getGtidFilePath(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Archiving physical backup: %s",
+			b.getTargetFilePath(),
+		),
+		fmt.Sprintf(
+			"tar -cf %s -C %s .",
+			b.getTargetFilePath(),
+			b.getStagingDir(),
+		),
+	}
+	cmds = append(cmds, b.serverVersionCmds(connectionFlags)...)
+	return NewBashCommand(cmds)
+}
+
+// backupDumpOpts returns the options passed to the tool that takes the backup, which default to the ones of every engine.
+func (b *BackupCommand) backupDumpOpts() string {
+	if b.BackupOpts.DumpOpts != nil {
+		return strings.Join(b.BackupOpts.DumpOpts, " ")
+	}
+	if b.Physical {
+		return ""
+	}
+	if b.MydumperEngine {
+		return "--events --routines --triggers"
+	}
+	if len(b.databaseSelections()) > 0 {
+		return "--single-transaction --events --routines --dump-slave=2 --master-data=2 --gtid"
+	}
+	return "--single-transaction --events --routines --dump-slave=2 --master-data=2 --gtid --all-databases"
+}
+
+// serverVersionCmds writes the version of the server the backup is taken from, so it can be recorded in the backup manifest.
+func (b *BackupCommand) serverVersionCmds(connectionFlags string) []string {
+	return []string{
+		"echo 💾 Getting server version",
+		fmt.Sprintf(
+			"(mariadb %s --skip-column-names -e 'SELECT VERSION()' || true) > %s",
+			connectionFlags,
+			b.getServerVersionFilePath(),
+		),
+	}
+}
+
+func (b *BackupCommand) MariadbOperatorBackup() *Command {
+	args := []string{
+		"backup",
+		"--path",
+		b.Path,
+		"--target-file-path",
+		b.TargetFilePath,
+		"--max-retention",
+		b.MaxRetentionDuration.String(),
+		"--log-level",
+		b.LogLevel,
+	}
+	if dumpOpts := b.backupDumpOpts(); dumpOpts != "" {
+		args = append(args,
+			"--dump-opts",
+			dumpOpts,
+		)
+	}
+	args = append(args, b.retentionPolicyArgs()...)
+	args = append(args, b.catalogArgs()...)
+	args = append(args, b.encryptionArgs()...)
+	args = append(args, b.storageArgs()...)
+	return NewCommand(nil, args)
+}
+
+func (b *BackupCommand) MariadbOperatorRestore() *Command {
+	args := []string{
+		"backup",
+		"restore",
+		"--path",
+		b.Path,
+		"--target-time",
+		backuppkg.FormatBackupDate(b.TargetTime),
+		"--target-file-path",
+		b.TargetFilePath,
+		"--log-level",
+		b.LogLevel,
+	}
+	if b.Physical {
+		args = append(args, "--physical")
+	}
+	if b.ReplayBinlogs {
+		args = append(args, "--binlogs")
+	}
+	args = append(args, b.encryptionArgs()...)
+	if b.DecryptPath != "" {
+		args = append(args,
+			"--decrypt-path",
+			b.DecryptPath,
+		)
+	}
+	if b.RestoreDatabase != "" {
+		args = append(args,
+			"--database",
+			b.RestoreDatabase,
+		)
+	}
+	args = append(args, b.storageArgs()...)
+	return NewCommand(nil, args)
+}
+
+func (b *BackupCommand) MariaBackupRestore() *Command {
+	cmds := []string{
+		"set -euo pipefail",
+		"echo 💾 Cleaning up staging directory",
+		fmt.Sprintf(
+			"rm -rf %s",
+			b.getStagingDir(),
+		),
+		fmt.Sprintf(
+			"mkdir -p %s",
+			b.getStagingDir(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Extracting physical backup: %s",
+			b.getRestoreFilePath(),
+		),
+		fmt.Sprintf(
+			"tar -xf %s -C %s",
+			b.getRestoreFilePath(),
+			b.getStagingDir(),
+		),
+		fmt.Sprintf(
+			"echo 💾 Copying back physical backup into datadir: %s",
+			b.DatadirPath,
+		),
+		fmt.Sprintf(
+			"mariabackup --copy-back --force-non-empty-directories --target-dir=%s --datadir=%s",
+			b.getStagingDir(),
+			b.DatadirPath,
+		),
+	}
+	return NewBashCommand(cmds)
+}
+
+// Myloader loads in parallel the tables of a backup taken with mydumper. Backups taken with other engines are skipped,
+// as they are restored by MariadbRestore.
+func (b *BackupCommand) Myloader(mariadb *mariadbv1alpha1.MariaDB) *Command {
+	cmds := []string{
+		"set -euo pipefail",
+		"echo 💾 Detecting backup format",
+		fmt.Sprintf(
+			"case \"$(cat '%s')\" in *.%s) ;; *) echo 💾 Skipping myloader, not a mydumper backup; exit 0;; esac",
+			b.TargetFilePath,
+			backuppkg.MydumperExtension,
+		),
+		"echo 💾 Cleaning up dump directory",
+		fmt.Sprintf(
+			"rm -rf %s",
+			b.getMydumperDir(b.getRestoreDir()),
+		),
+		fmt.Sprintf(
+			"mkdir -p %s",
+			b.getMydumperDir(b.getRestoreDir()),
+		),
+		fmt.Sprintf(
+			"echo 💾 Extracting backup: %s",
+			b.getRestoreFilePath(),
+		),
+		fmt.Sprintf(
+			"tar -xf %s -C %s",
+			b.getRestoreFilePath(),
+			b.getMydumperDir(b.getRestoreDir()),
+		),
+		fmt.Sprintf(
+			"echo 💾 Restoring backup: %s",
+			b.getRestoreFilePath(),
+		),
+		fmt.Sprintf(
+			"myloader %s --overwrite-tables --directory=%s%s",
+			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+			b.getMydumperDir(b.getRestoreDir()),
+			b.myloaderDatabaseOpts(),
+		),
+	}
+	return NewBashCommand(cmds)
+}
+
+// mydumperBackupCmd handles a backup taken with mydumper when restoring, which has already been loaded by myloader
+// if the Mydumper engine is enabled. Otherwise, the restore fails, as the backup cannot be restored by mariadb.
+func (b *BackupCommand) mydumperBackupCmd() string {
+	if b.MydumperEngine {
+		return "echo 💾 Backup restored by myloader; exit 0"
+	}
+	return "echo 💾 Backup taken with mydumper, the Mydumper engine must be set to restore it; exit 1"
+}
+
+func (b *BackupCommand) myloaderDatabaseOpts() string {
+	if b.RestoreDatabase == "" {
+		return ""
+	}
+	opts := fmt.Sprintf(" --source-db=%s", shellQuote(b.RestoreDatabase))
+	if b.RestoreTargetDatabase != "" {
+		opts += fmt.Sprintf(" --database=%s", shellQuote(b.RestoreTargetDatabase))
+	}
+	return opts
+}
+
+func (b *BackupCommand) MariadbRestore(mariadb *mariadbv1alpha1.MariaDB) *Command {
+	dumpOpts := ""
+	if b.BackupOpts.DumpOpts != nil {
+		dumpOpts = strings.Join(b.BackupOpts.DumpOpts, " ")
+	}
+	if b.S3Streaming {
+		return b.streamingRestore(mariadb, dumpOpts)
+	}
+	cmds := []string{
+		"set -euo pipefail",
+		"echo 💾 Detecting backup format",
+		fmt.Sprintf(
+			"case \"$(cat '%s')\" in *.%s) %s;; esac",
+			b.TargetFilePath,
+			backuppkg.MydumperExtension,
+			b.mydumperBackupCmd(),
+		),
+		"echo 💾 Detecting backup compression",
+		b.decompressCmd(),
+		fmt.Sprintf(
+			"for BACKUP_FILE in $(cat '%s'); do echo 💾 Restoring backup: ${BACKUP_FILE}; "+
+				"${DECOMPRESS} %s/${BACKUP_FILE}%s | mariadb %s %s; done",
+			b.TargetFilePath,
+			b.getRestoreDir(),
+			b.databaseFilterPipe(),
+			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+			dumpOpts,
+		),
+	}
+	if b.ReplayBinlogs {
+		cmds = append(cmds, b.replayBinlogsCmds(mariadb)...)
+	}
+	return NewBashCommand(cmds)
+}
+
+// streamingRestore restores the backup streamed by the mariadb-operator container, which completes the stream once
+// the backup has been verified. Otherwise, the restore fails, although the backup might have been partially restored.
+func (b *BackupCommand) streamingRestore(mariadb *mariadbv1alpha1.MariaDB, dumpOpts string) *Command {
+	cmds := []string{
+		"set -euo pipefail",
+		"echo 💾 Waiting for backup stream",
+		fmt.Sprintf(
+			"until [ -p %s ]; do sleep 1; done",
+			b.getStreamPath(),
+		),
+		"echo 💾 Detecting backup compression",
+		b.decompressCmd(),
+		fmt.Sprintf(
+			"echo 💾 Restoring backup: $(cat '%s')",
+			b.TargetFilePath,
+		),
+		fmt.Sprintf(
+			"${DECOMPRESS} < %s%s | mariadb %s %s",
+			b.getStreamPath(),
+			b.databaseFilterPipe(),
+			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+			dumpOpts,
+		),
+		"echo 💾 Waiting for backup stream to be completed",
+		fmt.Sprintf(
+			"for i in $(seq 1 30); do if [ -f %s ]; then exit 0; fi; sleep 1; done; echo 💾 Backup stream was not completed; exit 1",
+			b.getStreamCompletePath(),
+		),
+	}
+	return NewBashCommand(cmds)
+}
+
+// databaseFilterPipe extracts the section of the database to be restored from a dump containing all the databases, which
+// starts with the "Current Database" comment written by mariadb-dump, along with the header of the dump. The database is
+// renamed in the CREATE DATABASE and USE statements when restoring it into a different target database.
+func (b *BackupCommand) databaseFilterPipe() string {
+	if b.RestoreDatabase == "" {
+		return ""
+	}
+	targetDatabase := b.RestoreDatabase
+	if b.RestoreTargetDatabase != "" {
+		targetDatabase = b.RestoreTargetDatabase
+	}
+	program := strings.Join([]string{
+		`function rename(s) { out = "";`,
+		`while ((i = index(s, src)) > 0) { out = out substr(s, 1, i - 1) dst; s = substr(s, i + length(src)) };`,
+		`return out s }`,
+		`BEGIN { print_line = 1 }`,
+		`/^-- Current Database: / { print_line = ($0 == ("-- Current Database: " src)) }`,
+		`print_line && /^(CREATE DATABASE|USE) / { $0 = rename($0) }`,
+		`print_line`,
+	}, " ")
+	return fmt.Sprintf(
+		" | awk -v src=%s -v dst=%s %s",
+		shellQuote("`"+b.RestoreDatabase+"`"),
+		shellQuote("`"+targetDatabase+"`"),
+		shellQuote(program),
+	)
+}
+
+// MariadbVerify restores the target backup into an ephemeral MariaDB instance and runs a sanity query against it.
+func (b *BackupCommand) MariadbVerify() *Command {
+	socket := fmt.Sprintf("%s/mariadb.sock", b.VerifyDatadirPath)
+	cmds := []string{
+		"set -euo pipefail",
+	}
+	if b.Physical {
+		cmds = append(cmds,
+			"echo 💾 Cleaning up staging directory",
+			fmt.Sprintf(
+				"rm -rf %s %s",
+				b.getStagingDir(),
+				b.VerifyDatadirPath,
+			),
+			fmt.Sprintf(
+				"mkdir -p %s %s",
+				b.getStagingDir(),
+				b.VerifyDatadirPath,
+			),
+			fmt.Sprintf(
+				"echo 💾 Extracting physical backup: %s",
+				b.getRestoreFilePath(),
+			),
+			fmt.Sprintf(
+				"tar -xf %s -C %s",
+				b.getRestoreFilePath(),
+				b.getStagingDir(),
+			),
+			"echo 💾 Copying back physical backup into ephemeral datadir",
+			fmt.Sprintf(
+				"mariabackup --copy-back --target-dir=%s --datadir=%s",
+				b.getStagingDir(),
+				b.VerifyDatadirPath,
+			),
+		)
+	} else {
+		cmds = append(cmds,
+			"echo 💾 Detecting backup compression",
+			b.decompressCmd(),
+			"echo 💾 Initializing ephemeral datadir",
+			fmt.Sprintf(
+				"rm -rf %s",
+				b.VerifyDatadirPath,
+			),
+			fmt.Sprintf(
+				"mariadb-install-db --datadir=%s --skip-test-db > /dev/null",
+				b.VerifyDatadirPath,
+			),
+		)
+	}
+	cmds = append(cmds,
+		"echo 💾 Starting ephemeral MariaDB",
+		fmt.Sprintf(
+			"mariadbd --user=root --datadir=%s --socket=%s --skip-networking --skip-grant-tables --skip-log-bin & MARIADB_PID=$!",
+			b.VerifyDatadirPath,
+			socket,
+		),
+		fmt.Sprintf(
+			"for i in $(seq 1 60); do mariadb-admin --socket=%s ping --silent && break; sleep 1; done",
+			socket,
+		),
+	)
+	if !b.Physical {
+		cmds = append(cmds,
+			fmt.Sprintf(
+				"for BACKUP_FILE in $(cat '%s'); do echo 💾 Restoring backup: ${BACKUP_FILE}; "+
+					"${DECOMPRESS} %s/${BACKUP_FILE} | mariadb --socket=%s; done",
+				b.TargetFilePath,
+				b.getRestoreDir(),
+				socket,
+			),
+		)
+	}
+	cmds = append(cmds,
+		"echo 💾 Running verification query",
+		fmt.Sprintf(
+			"mariadb --socket=%s -e \"${%s}\"",
+			socket,
+			b.VerifySqlQueryEnv,
+		),
+		"echo 💾 Stopping ephemeral MariaDB",
+		fmt.Sprintf(
+			"mariadb-admin --socket=%s shutdown",
+			socket,
+		),
+		"wait ${MARIADB_PID}",
+	)
+	return NewBashCommand(cmds)
+}
+
+func (b *BackupCommand) MariadbOperatorBinlogList() *Command {
+	args := []string{
+		"backup",
+		"binlog",
+		"list",
+		"--path",
+		b.Path,
+		"--target-file-path",
+		b.TargetFilePath,
+		"--log-level",
+		b.LogLevel,
+	}
+	args = append(args, b.storageArgs()...)
+	return NewCommand(nil, args)
+}
+
+func (b *BackupCommand) MariadbBinlogArchive(mariadb *mariadbv1alpha1.MariaDB) *Command {
+	connFlags := PrimaryConnectionFlags(&b.BackupOpts.CommandOpts, mariadb)
+	cmds := []string{
+		"set -euo pipefail",
+		"echo 💾 Creating binlogs directory",
+		fmt.Sprintf(
+			"mkdir -p %s",
+			b.getBinlogsDir(),
+		),
+		"echo 💾 Listing closed binlogs",
+		// The server name is obtained in the same session as the binlogs, as all the servers share the same binlog basename.
+		fmt.Sprintf(
+			"export BINLOGS=$(mariadb %s --skip-column-names -e 'SELECT @@hostname; SHOW BINARY LOGS')",
+			connFlags,
+		),
+		"export SERVER=$(head -n 1 <<< \"${BINLOGS}\" | tr '.' '-')",
+		"export BINLOGS=$(tail -n +2 <<< \"${BINLOGS}\" | cut -f1 | head -n -1)",
+		fmt.Sprintf(
+			"for BINLOG in ${BINLOGS}; do "+
+				"if grep -qx \"${SERVER}.${BINLOG}\" %s; then continue; fi; "+
+				"echo \"💾 Archiving binlog: ${BINLOG} from ${SERVER}\"; "+
+				"mariadb-binlog %s --read-from-remote-server --raw --result-file=%s/binlog.$(date -u +'%s').${SERVER}. ${BINLOG}; "+
+				"done",
+			b.TargetFilePath,
+			connFlags,
+			b.getBinlogsDir(),
+			"%Y-%m-%dT%H:%M:%SZ",
+		),
+	}
+	return NewBashCommand(cmds)
+}
+
+func (b *BackupCommand) MariadbOperatorBinlogPush() *Command {
+	args := []string{
+		"backup",
+		"binlog",
+		"push",
+		"--path",
+		b.Path,
+		"--target-file-path",
+		b.TargetFilePath,
+		"--max-retention",
+		b.MaxRetentionDuration.String(),
+		"--log-level",
+		b.LogLevel,
+	}
+	args = append(args, b.storageArgs()...)
+	return NewCommand(nil, args)
+}
+
+func (b *BackupCommand) replayBinlogsCmds(mariadb *mariadbv1alpha1.MariaDB) []string {
+	binlogsTargetFile := fmt.Sprintf("%s/%s", b.getBinlogsDir(), backuppkg.BinlogsTargetFile)
+	stopDatetime := b.TargetTime.UTC().Format(time.DateTime)
+	return []string{
+		"echo 💾 Getting GTID from backup",
+		fmt.Sprintf(
+			"export BINLOG_GTID=$(${DECOMPRESS} %s%s || true)",
+			b.getRestoreFilePath(),
+			gtidPipe,
+		),
+		fmt.Sprintf(
+			"if [ -z \"${BINLOG_GTID}\" ]; then echo 💾 GTID not found in backup, unable to replay binlogs; exit 1; "+
+				"elif [ ! -s %s ]; then echo 💾 No binlogs found to replay; exit 1; "+
+				"else echo \"💾 Replaying binlogs from GTID ${BINLOG_GTID} up to %s\"; "+
+				"mariadb-binlog --start-position=\"${BINLOG_GTID}\" --stop-datetime=\"%s\" $(sed 's|^|%s/|' %s) | mariadb %s; "+
+				"fi",
+			binlogsTargetFile,
+			stopDatetime,
+			stopDatetime,
+			b.getBinlogsDir(),
+			binlogsTargetFile,
+			ConnectionFlags(&b.BackupOpts.CommandOpts, mariadb),
+		),
+	}
+}
+
+func (b *BackupCommand) compressPipe() string {
+	if b.Physical || !b.Compression.IsCompressed() {
+		return ""
+	}
+	return fmt.Sprintf(" | %s", compressCmds[b.Compression])
+}
+
+// readCmd returns the command that outputs the target backup file to stdout, decompressing it if needed.
+func (b *BackupCommand) readCmd() string {
+	if b.Physical || !b.Compression.IsCompressed() {
+		return "cat"
+	}
+	return decompressCmds[b.Compression]
+}
+
+// decompressCmd exports the command that outputs the target backup file to stdout, detecting its compression by extension.
+func (b *BackupCommand) decompressCmd() string {
+	var cases []string
+	for _, algorithm := range backuppkg.CompressAlgorithms() {
+		cases = append(cases, fmt.Sprintf(
+			"*.%s) export DECOMPRESS='%s';;",
+			algorithm.Extension(),
+			decompressCmds[algorithm],
+		))
+	}
+	return fmt.Sprintf(
+		"case \"$(cat '%s')\" in %s *) export DECOMPRESS='cat';; esac",
+		b.TargetFilePath,
+		strings.Join(cases, " "),
+	)
+}
+
+func (b *BackupCommand) newBackupFile() string {
+	return fmt.Sprintf(
+		"backup.$(date -u +'%s').%s",
+		"%Y-%m-%dT%H:%M:%SZ",
+		b.backupFileExtension(),
+	)
+}
+
+func (b *BackupCommand) backupFileExtension() string {
+	if b.MydumperEngine {
+		return backuppkg.MydumperExtension
+	}
+	if b.Physical {
+		return "tar"
+	}
+	if b.Compression.IsCompressed() {
+		return fmt.Sprintf("sql.%s", b.Compression.Extension())
+	}
+	return "sql"
+}
+
+func (b *BackupCommand) getMydumperDir(path string) string {
+	return fmt.Sprintf("%s/mydumper", path)
+}
+
+func (b *BackupCommand) getBinlogsDir() string {
+	return fmt.Sprintf("%s/%s", b.Path, backuppkg.BinlogsDir)
+}
+
+func (b *BackupCommand) getStagingDir() string {
+	return fmt.Sprintf("%s/mariabackup", b.StagingPath)
+}
+
+func (b *BackupCommand) getGtidFilePath() string {
+	return fmt.Sprintf("%s/%s", b.Path, backuppkg.GtidFile)
+}
+
+func (b *BackupCommand) getServerVersionFilePath() string {
+	return fmt.Sprintf("%s/%s", b.Path, backuppkg.ServerVersionFile)
+}
+
+func (b *BackupCommand) getStreamPath() string {
+	return fmt.Sprintf("%s/%s", b.Path, backuppkg.StreamFile)
+}
+
+func (b *BackupCommand) getStreamCompletePath() string {
+	return fmt.Sprintf("%s/%s", b.Path, backuppkg.StreamCompleteFile)
+}
+
+func (b *BackupCommand) getTargetFilePath() string {
+	return fmt.Sprintf("%s/$(cat '%s')", b.Path, b.TargetFilePath)
+}
+
+// getRestoreFilePath returns the path of the file to be restored, which lives in the decrypt path when decryption is enabled.
+func (b *BackupCommand) getRestoreFilePath() string {
+	return fmt.Sprintf("%s/$(cat '%s')", b.getRestoreDir(), b.TargetFilePath)
+}
+
+// getRestoreDir returns the directory of the files to be restored.
+func (b *BackupCommand) getRestoreDir() string {
+	if b.DecryptPath == "" {
+		return b.Path
+	}
+	return b.DecryptPath
+}
+
+func (b *BackupCommand) encryptionArgs() []string {
+	if b.EncryptionKeyPath == "" {
+		return nil
+	}
+	return []string{
+		"--encryption-key-path",
+		b.EncryptionKeyPath,
+	}
+}
+
+func (b *BackupCommand) retentionPolicyArgs() []string {
+	if !b.RetentionPolicy.IsEnabled() {
+		return nil
+	}
+	var args []string
+	keepFlags := []struct {
+		flag string
+		keep int
+	}{
+		{"--keep-hourly", b.RetentionPolicy.Hourly},
+		{"--keep-daily", b.RetentionPolicy.Daily},
+		{"--keep-weekly", b.RetentionPolicy.Weekly},
+		{"--keep-monthly", b.RetentionPolicy.Monthly},
+		{"--keep-yearly", b.RetentionPolicy.Yearly},
+	}
+	for _, f := range keepFlags {
+		if f.keep > 0 {
+			args = append(args, f.flag, strconv.Itoa(f.keep))
+		}
+	}
+	return args
+}
+
+func (b *BackupCommand) catalogArgs() []string {
+	if b.CatalogPath == "" {
+		return nil
+	}
+	return []string{
+		"--catalog-path",
+		b.CatalogPath,
+		"--catalog-limit",
+		strconv.Itoa(b.CatalogLimit),
+	}
+}
+
+func (b *BackupCommand) storageArgs() []string {
+	args := b.s3Args()
+	args = append(args, b.azureBlobArgs()...)
+	return append(args, b.gcsArgs()...)
+}
+
+func (b *BackupCommand) s3Args() []string {
+	if !b.S3 {
+		return nil
+	}
+	args := []string{
+		"--s3",
+		"--s3-bucket",
+		b.S3Bucket,
+		"--s3-endpoint",
+		b.S3Endpoint,
+	}
+	if b.S3Region != "" {
+		args = append(args,
+			"--s3-region",
+			b.S3Region,
+		)
+	}
+	if b.S3TLS {
+		args = append(args,
+			"--s3-tls",
+		)
+		if b.S3CACertPath != "" {
+			args = append(args,
+				"--s3-ca-cert-path",
+				b.S3CACertPath,
+			)
+		}
+	}
+	if b.S3IAM {
+		args = append(args,
+			"--s3-iam",
+		)
+	}
+	if b.S3SSE != "" {
+		args = append(args,
+			"--s3-sse",
+			b.S3SSE,
+		)
+		if b.S3SSEKMSKeyID != "" {
+			args = append(args,
+				"--s3-sse-kms-key-id",
+				b.S3SSEKMSKeyID,
+			)
+		}
+		if b.S3SSECustomerKeyPath != "" {
+			args = append(args,
+				"--s3-sse-customer-key-path",
+				b.S3SSECustomerKeyPath,
+			)
+		}
+	}
+	if b.S3StorageClass != "" {
+		args = append(args,
+			"--s3-storage-class",
+			b.S3StorageClass,
+		)
+	}
+	if len(b.S3Tags) > 0 {
+		keys := make([]string, 0, len(b.S3Tags))
+		for k := range b.S3Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			args = append(args,
+				"--s3-tags",
+				fmt.Sprintf("%s=%s", k, b.S3Tags[k]),
+			)
+		}
+	}
+	if b.S3Prefix != "" {
+		args = append(args,
 			"--s3-prefix",
 			b.S3Prefix,
 		)
 	}
+	if b.S3Streaming {
+		args = append(args,
+			"--s3-streaming",
+		)
+	}
+	return args
+}
+
+func (b *BackupCommand) azureBlobArgs() []string {
+	if !b.AzureBlob {
+		return nil
+	}
+	args := []string{
+		"--azure-blob",
+		"--azure-blob-container",
+		b.AzureBlobContainer,
+		"--azure-blob-storage-account",
+		b.AzureBlobAccount,
+	}
+	if b.AzureBlobServiceURL != "" {
+		args = append(args,
+			"--azure-blob-service-url",
+			b.AzureBlobServiceURL,
+		)
+	}
+	if b.AzureBlobPrefix != "" {
+		args = append(args,
+			"--azure-blob-prefix",
+			b.AzureBlobPrefix,
+		)
+	}
+	return args
+}
+
+func (b *BackupCommand) gcsArgs() []string {
+	if !b.GCS {
+		return nil
+	}
+	args := []string{
+		"--gcs",
+		"--gcs-bucket",
+		b.GCSBucket,
+	}
+	if b.GCSPrefix != "" {
+		args = append(args,
+			"--gcs-prefix",
+			b.GCSPrefix,
+		)
+	}
+	if b.GCSCredentialsPath != "" {
+		args = append(args,
+			"--gcs-credentials-path",
+			b.GCSCredentialsPath,
+		)
+	}
 	return args
 }
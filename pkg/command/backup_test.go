@@ -0,0 +1,707 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	backuppkg "github.com/mariadb-operator/mariadb-operator/pkg/backup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMariadbVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []BackupOpt
+		wantCmds    []string
+		notWantCmds []string
+	}{
+		{
+			name: "logical",
+			opts: nil,
+			wantCmds: []string{
+				"set -euo pipefail",
+				"mariadb-install-db --datadir=/verify/datadir --skip-test-db > /dev/null",
+				"mariadbd --user=root --datadir=/verify/datadir --socket=/verify/datadir/mariadb.sock --skip-networking " +
+					"--skip-grant-tables --skip-log-bin & MARIADB_PID=$!",
+				"for BACKUP_FILE in $(cat '/backup/0-backup-target.txt'); do echo 💾 Restoring backup: ${BACKUP_FILE}; " +
+					"${DECOMPRESS} /backup/${BACKUP_FILE} | mariadb --socket=/verify/datadir/mariadb.sock; done",
+				"mariadb --socket=/verify/datadir/mariadb.sock -e \"${VERIFY_SQL_QUERY}\"",
+				"mariadb-admin --socket=/verify/datadir/mariadb.sock shutdown",
+				"wait ${MARIADB_PID}",
+			},
+			notWantCmds: []string{
+				"mariabackup",
+			},
+		},
+		{
+			name: "physical",
+			opts: []BackupOpt{
+				WithBackupPhysical("/verify/datadir", "/verify"),
+			},
+			wantCmds: []string{
+				"set -euo pipefail",
+				"tar -xf /backup/$(cat '/backup/0-backup-target.txt') -C /verify/mariabackup",
+				"mariabackup --copy-back --target-dir=/verify/mariabackup --datadir=/verify/datadir",
+				"mariadb --socket=/verify/datadir/mariadb.sock -e \"${VERIFY_SQL_QUERY}\"",
+				"wait ${MARIADB_PID}",
+			},
+			notWantCmds: []string{
+				"mariadb-install-db",
+				"Restoring backup",
+			},
+		},
+		{
+			name: "encrypted",
+			opts: []BackupOpt{
+				WithBackupDecryption("/encryption/key", "/decrypt"),
+			},
+			wantCmds: []string{
+				"${DECOMPRESS} /decrypt/${BACKUP_FILE} | mariadb --socket=/verify/datadir/mariadb.sock",
+			},
+			notWantCmds: []string{
+				"${DECOMPRESS} /backup/${BACKUP_FILE}",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []BackupOpt{
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupVerify("/verify/datadir", "VERIFY_SQL_QUERY"),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			}
+			cmd, err := NewBackupCommand(append(opts, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			verify := cmd.MariadbVerify()
+			if strings.Join(verify.Command, " ") != "bash -c" {
+				t.Fatalf("unexpected command, expected: %s got: %v", "bash -c", verify.Command)
+			}
+			if len(verify.Args) != 1 {
+				t.Fatalf("expecting a single bash script, got: %v", verify.Args)
+			}
+			script := verify.Args[0]
+			for _, wantCmd := range tt.wantCmds {
+				if !strings.Contains(script, wantCmd) {
+					t.Errorf("expecting script to contain: %s\nscript: %s", wantCmd, script)
+				}
+			}
+			for _, notWantCmd := range tt.notWantCmds {
+				if strings.Contains(script, notWantCmd) {
+					t.Errorf("expecting script not to contain: %s\nscript: %s", notWantCmd, script)
+				}
+			}
+		})
+	}
+}
+
+func TestMariadbDumpDatabases(t *testing.T) {
+	tests := []struct {
+		name      string
+		databases []string
+		tables    []string
+		wantCmds  []string
+	}{
+		{
+			name:      "databases and tables",
+			databases: []string{"db1"},
+			tables:    []string{"db2.table1", "db2.table2"},
+			wantCmds: []string{
+				"export BACKUP_FILE=backup.${BACKUP_DATE}.'db1'.sql",
+				"(echo 'CREATE DATABASE IF NOT EXISTS `db1`; USE `db1`;' && mariadb-dump",
+				" 'db1') > /backup/${BACKUP_FILE}",
+				"export BACKUP_FILE=backup.${BACKUP_DATE}.'db2'.sql",
+				" 'db2' 'table1' 'table2') > /backup/${BACKUP_FILE}",
+			},
+		},
+		{
+			name:      "shell metacharacters",
+			databases: []string{"db1'; rm -rf /backup; echo '$(id)"},
+			wantCmds: []string{
+				"export BACKUP_FILE=backup.${BACKUP_DATE}.'db1'\\''; rm -rf /backup; echo '\\''$(id)'.sql",
+				"(echo 'CREATE DATABASE IF NOT EXISTS `db1'\\''; rm -rf /backup; echo '\\''$(id)`; " +
+					"USE `db1'\\''; rm -rf /backup; echo '\\''$(id)`;' && mariadb-dump",
+				" 'db1'\\''; rm -rf /backup; echo '\\''$(id)') > /backup/${BACKUP_FILE}",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := NewBackupCommand(
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupDatabases(tt.databases, tt.tables),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			assertScript(t, cmd.MariadbDump(&mariadbv1alpha1.Backup{}, testMariaDB()), tt.wantCmds, nil)
+		})
+	}
+}
+
+func TestMydumper(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []BackupOpt
+		wantCmds    []string
+		notWantCmds []string
+	}{
+		{
+			name: "default",
+			opts: []BackupOpt{
+				WithBackupMydumper(nil),
+			},
+			wantCmds: []string{
+				"set -euo pipefail",
+				"export BACKUP_FILE=backup.$(date -u +'%Y-%m-%dT%H:%M:%SZ').mydumper.tar",
+				"rm -rf /backup/mydumper",
+				"mydumper --user=${MARIADB_USER} --password=${MARIADB_PASSWORD} --host=mariadb.default.svc.cluster.local --port=3306 " +
+					"--threads=4 --outputdir=/backup/mydumper",
+				"grep -m 1 -E 'GTID:|Executed_Gtid_Set' /backup/mydumper/metadata",
+				"tar -cf /backup/$(cat '/backup/0-backup-target.txt') -C /backup/mydumper .",
+			},
+			notWantCmds: []string{
+				"--rows",
+				"--chunk-filesize",
+				"--compress",
+				"wsrep_desync",
+			},
+		},
+		{
+			name: "options",
+			opts: []BackupOpt{
+				WithBackupMydumper(&mariadbv1alpha1.Mydumper{
+					Threads:       8,
+					Rows:          100000,
+					ChunkFilesize: 64,
+				}),
+				WithBackupCompression(mariadbv1alpha1.CompressZstd),
+			},
+			wantCmds: []string{
+				"--threads=8 --rows=100000 --chunk-filesize=64 --compress=ZSTD --outputdir=/backup/mydumper",
+				"export BACKUP_FILE=backup.$(date -u +'%Y-%m-%dT%H:%M:%SZ').mydumper.tar",
+			},
+		},
+		{
+			name: "unsupported compression",
+			opts: []BackupOpt{
+				WithBackupMydumper(nil),
+				WithBackupCompression(mariadbv1alpha1.CompressBzip2),
+			},
+			wantCmds: []string{
+				"--threads=4 --outputdir=/backup/mydumper",
+			},
+			notWantCmds: []string{
+				"--compress",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []BackupOpt{
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			}
+			cmd, err := NewBackupCommand(append(opts, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			assertScript(t, cmd.Mydumper(testMariaDB()), tt.wantCmds, tt.notWantCmds)
+		})
+	}
+}
+
+func TestMyloader(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []BackupOpt
+		wantCmds    []string
+		notWantCmds []string
+	}{
+		{
+			name: "all databases",
+			wantCmds: []string{
+				"set -euo pipefail",
+				"case \"$(cat '/backup/0-backup-target.txt')\" in *.mydumper.tar) ;; " +
+					"*) echo 💾 Skipping myloader, not a mydumper backup; exit 0;; esac",
+				"rm -rf /backup/mydumper",
+				"tar -xf /backup/$(cat '/backup/0-backup-target.txt') -C /backup/mydumper",
+				"myloader --user=${MARIADB_USER} --password=${MARIADB_PASSWORD} --host=mariadb.default.svc.cluster.local --port=3306 " +
+					"--overwrite-tables --directory=/backup/mydumper",
+			},
+			notWantCmds: []string{
+				"--source-db",
+			},
+		},
+		{
+			name: "database",
+			opts: []BackupOpt{
+				WithBackupRestoreDatabase("db1", "db2"),
+			},
+			wantCmds: []string{
+				"--overwrite-tables --directory=/backup/mydumper --source-db='db1' --database='db2'",
+			},
+		},
+		{
+			name: "encrypted",
+			opts: []BackupOpt{
+				WithBackupDecryption("/encryption/key", "/decrypt"),
+			},
+			wantCmds: []string{
+				"tar -xf /decrypt/$(cat '/backup/0-backup-target.txt') -C /decrypt/mydumper",
+				"--overwrite-tables --directory=/decrypt/mydumper",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []BackupOpt{
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupMydumper(nil),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			}
+			cmd, err := NewBackupCommand(append(opts, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			assertScript(t, cmd.Myloader(testMariaDB()), tt.wantCmds, tt.notWantCmds)
+		})
+	}
+}
+
+func TestMyloaderDatabaseOpts(t *testing.T) {
+	tests := []struct {
+		name           string
+		database       string
+		targetDatabase string
+		wantOpts       string
+	}{
+		{
+			name:     "all databases",
+			wantOpts: "",
+		},
+		{
+			name:     "database",
+			database: "db1",
+			wantOpts: " --source-db='db1'",
+		},
+		{
+			name:           "target database",
+			database:       "db1",
+			targetDatabase: "db2",
+			wantOpts:       " --source-db='db1' --database='db2'",
+		},
+		{
+			name:           "target database without database",
+			targetDatabase: "db2",
+			wantOpts:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := NewBackupCommand(
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupRestoreDatabase(tt.database, tt.targetDatabase),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			if opts := cmd.myloaderDatabaseOpts(); opts != tt.wantOpts {
+				t.Errorf("unexpected myloader options, expected: %q got: %q", tt.wantOpts, opts)
+			}
+		})
+	}
+}
+
+func TestMariadbRestoreMydumperBackup(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []BackupOpt
+		wantCmds []string
+	}{
+		{
+			name: "mydumper engine",
+			opts: []BackupOpt{
+				WithBackupMydumper(nil),
+			},
+			wantCmds: []string{
+				"case \"$(cat '/backup/0-backup-target.txt')\" in *.mydumper.tar) echo 💾 Backup restored by myloader; exit 0;; esac",
+			},
+		},
+		{
+			name: "mariadb-dump engine",
+			wantCmds: []string{
+				"case \"$(cat '/backup/0-backup-target.txt')\" in *.mydumper.tar) " +
+					"echo 💾 Backup taken with mydumper, the Mydumper engine must be set to restore it; exit 1;; esac",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []BackupOpt{
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			}
+			cmd, err := NewBackupCommand(append(opts, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			assertScript(t, cmd.MariadbRestore(testMariaDB()), tt.wantCmds, nil)
+		})
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "empty",
+			s:    "",
+			want: "''",
+		},
+		{
+			name: "identifier",
+			s:    "db1",
+			want: "'db1'",
+		},
+		{
+			name: "metacharacters",
+			s:    "$(id) `id` ; | &",
+			want: "'$(id) `id` ; | &'",
+		},
+		{
+			name: "single quotes",
+			s:    "it's",
+			want: `'it'\''s'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shellQuote(tt.s); got != tt.want {
+				t.Errorf("unexpected quoted string, expected: %s got: %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDatabaseFilterPipe(t *testing.T) {
+	if _, err := exec.LookPath("awk"); err != nil {
+		t.Skip("awk not available")
+	}
+	dump := strings.Join([]string{
+		"-- MariaDB dump 10.19",
+		"/*!40101 SET NAMES utf8mb4 */;",
+		"",
+		"--",
+		"-- Current Database: `db1`",
+		"--",
+		"",
+		"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `db1` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;",
+		"",
+		"USE `db1`;",
+		"CREATE TABLE `users` (`id` int);",
+		"INSERT INTO `users` VALUES (1);",
+		"",
+		"--",
+		"-- Current Database: `db10`",
+		"--",
+		"",
+		"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `db10` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;",
+		"",
+		"USE `db10`;",
+		"CREATE TABLE `orders` (`id` int);",
+		"",
+		"--",
+		"-- Current Database: `db2`",
+		"--",
+		"",
+		"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `db2` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;",
+		"",
+		"USE `db2`;",
+		"CREATE TABLE `items` (`id` int);",
+		"CREATE VIEW `v` AS SELECT * FROM `db1`.`users`;",
+		"",
+		"-- Dump completed",
+	}, "\n") + "\n"
+
+	tests := []struct {
+		name           string
+		database       string
+		targetDatabase string
+		wantLines      []string
+		notWantLines   []string
+	}{
+		{
+			name:     "first database",
+			database: "db1",
+			wantLines: []string{
+				"-- MariaDB dump 10.19",
+				"/*!40101 SET NAMES utf8mb4 */;",
+				"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `db1` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;",
+				"USE `db1`;",
+				"INSERT INTO `users` VALUES (1);",
+			},
+			notWantLines: []string{
+				"USE `db10`;",
+				"CREATE TABLE `orders` (`id` int);",
+				"USE `db2`;",
+				"-- Dump completed",
+			},
+		},
+		{
+			name:     "last database",
+			database: "db2",
+			wantLines: []string{
+				"-- MariaDB dump 10.19",
+				"USE `db2`;",
+				"CREATE TABLE `items` (`id` int);",
+				"-- Dump completed",
+			},
+			notWantLines: []string{
+				"USE `db1`;",
+				"USE `db10`;",
+				"INSERT INTO `users` VALUES (1);",
+			},
+		},
+		{
+			name:           "target database",
+			database:       "db1",
+			targetDatabase: "restored",
+			wantLines: []string{
+				"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `restored` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;",
+				"USE `restored`;",
+				"CREATE TABLE `users` (`id` int);",
+			},
+			notWantLines: []string{
+				"USE `db1`;",
+				"USE `db10`;",
+			},
+		},
+		{
+			name:           "fully qualified references are not renamed",
+			database:       "db2",
+			targetDatabase: "restored",
+			wantLines: []string{
+				"USE `restored`;",
+				"CREATE VIEW `v` AS SELECT * FROM `db1`.`users`;",
+			},
+		},
+		{
+			name:     "missing database",
+			database: "db3",
+			wantLines: []string{
+				"-- MariaDB dump 10.19",
+			},
+			notWantLines: []string{
+				"USE `db1`;",
+				"USE `db10`;",
+				"USE `db2`;",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := NewBackupCommand(
+				WithBackup("/backup", "/backup/0-backup-target.txt"),
+				WithBackupRestoreDatabase(tt.database, tt.targetDatabase),
+				WithBackupUserEnv("MARIADB_USER"),
+				WithBackupPasswordEnv("MARIADB_PASSWORD"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error creating command: %v", err)
+			}
+			filter := exec.Command("bash", "-c", "cat"+cmd.databaseFilterPipe())
+			filter.Stdin = strings.NewReader(dump)
+			out, err := filter.CombinedOutput()
+			if err != nil {
+				t.Fatalf("unexpected error filtering dump: %v\noutput: %s", err, out)
+			}
+			lines := strings.Split(string(out), "\n")
+			contains := func(line string) bool {
+				for _, l := range lines {
+					if l == line {
+						return true
+					}
+				}
+				return false
+			}
+			for _, line := range tt.wantLines {
+				if !contains(line) {
+					t.Errorf("expecting output to contain line: %s\noutput: %s", line, out)
+				}
+			}
+			for _, line := range tt.notWantLines {
+				if contains(line) {
+					t.Errorf("expecting output not to contain line: %s\noutput: %s", line, out)
+				}
+			}
+		})
+	}
+}
+
+func TestDatabaseFilterPipeAllDatabases(t *testing.T) {
+	cmd, err := NewBackupCommand(
+		WithBackup("/backup", "/backup/0-backup-target.txt"),
+		WithBackupUserEnv("MARIADB_USER"),
+		WithBackupPasswordEnv("MARIADB_PASSWORD"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating command: %v", err)
+	}
+	if pipe := cmd.databaseFilterPipe(); pipe != "" {
+		t.Errorf("expecting no filter when restoring all databases, got: %s", pipe)
+	}
+}
+
+func assertScript(t *testing.T, cmd *Command, wantCmds, notWantCmds []string) {
+	if strings.Join(cmd.Command, " ") != "bash -c" {
+		t.Fatalf("unexpected command, expected: %s got: %v", "bash -c", cmd.Command)
+	}
+	if len(cmd.Args) != 1 {
+		t.Fatalf("expecting a single bash script, got: %v", cmd.Args)
+	}
+	script := cmd.Args[0]
+	for _, wantCmd := range wantCmds {
+		if !strings.Contains(script, wantCmd) {
+			t.Errorf("expecting script to contain: %s\nscript: %s", wantCmd, script)
+		}
+	}
+	for _, notWantCmd := range notWantCmds {
+		if strings.Contains(script, notWantCmd) {
+			t.Errorf("expecting script not to contain: %s\nscript: %s", notWantCmd, script)
+		}
+	}
+}
+
+func TestMariadbBinlogArchive(t *testing.T) {
+	for _, bin := range []string{"bash", "grep", "head", "tail", "cut", "tr", "date"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("%s not available", bin)
+		}
+	}
+	dir := t.TempDir()
+	backupPath := filepath.Join(dir, "backup")
+	targetFilePath := filepath.Join(backupPath, "0-binlog-target.txt")
+	binPath := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(binPath, 0755); err != nil {
+		t.Fatalf("unexpected error creating bin directory: %v", err)
+	}
+	// Fake clients of a server named after TEST_SERVER, whose last binlog is still open.
+	fakeBins := map[string]string{
+		"mariadb": "#!/bin/bash\nprintf '%s\\nmariadb-bin.000001\\t100\\nmariadb-bin.000002\\t200\\n' \"${TEST_SERVER}\"\n",
+		"mariadb-binlog": "#!/bin/bash\n" +
+			"for arg in \"$@\"; do case \"${arg}\" in --result-file=*) PREFIX=\"${arg#--result-file=}\";; esac; done\n" +
+			"touch \"${PREFIX}${@: -1}\"\n",
+	}
+	for name, script := range fakeBins {
+		if err := os.WriteFile(filepath.Join(binPath, name), []byte(script), 0755); err != nil {
+			t.Fatalf("unexpected error writing fake %s: %v", name, err)
+		}
+	}
+
+	cmd, err := NewBackupCommand(
+		WithBackup(backupPath, targetFilePath),
+		WithBackupUserEnv("MARIADB_USER"),
+		WithBackupPasswordEnv("MARIADB_PASSWORD"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating command: %v", err)
+	}
+	archiveCmd := cmd.MariadbBinlogArchive(testMariaDB())
+
+	archive := func(server string) []string {
+		script := exec.Command(archiveCmd.Command[0], append(archiveCmd.Command[1:], archiveCmd.Args...)...)
+		script.Env = append(os.Environ(),
+			"PATH="+binPath+":"+os.Getenv("PATH"),
+			"TEST_SERVER="+server,
+			"MARIADB_USER=root",
+			"MARIADB_PASSWORD=password",
+		)
+		if out, err := script.CombinedOutput(); err != nil {
+			t.Fatalf("unexpected error archiving binlogs: %v\noutput: %s", err, out)
+		}
+
+		entries, err := os.ReadDir(filepath.Join(backupPath, backuppkg.BinlogsDir))
+		if err != nil {
+			t.Fatalf("unexpected error reading binlogs directory: %v", err)
+		}
+		var keys []string
+		for _, e := range entries {
+			key, err := backuppkg.GetBinlogKey(e.Name())
+			if err != nil {
+				t.Fatalf("unexpected archived binlog file: %s", e.Name())
+			}
+			keys = append(keys, key)
+		}
+		// Emulates the binlog list command, which writes the keys of the archived binlogs into the target file.
+		if err := os.WriteFile(targetFilePath, []byte(strings.Join(keys, "\n")), 0644); err != nil {
+			t.Fatalf("unexpected error writing target file: %v", err)
+		}
+		return keys
+	}
+	if err := os.MkdirAll(backupPath, 0755); err != nil {
+		t.Fatalf("unexpected error creating backup directory: %v", err)
+	}
+	if err := os.WriteFile(targetFilePath, nil, 0644); err != nil {
+		t.Fatalf("unexpected error writing target file: %v", err)
+	}
+
+	steps := []struct {
+		server   string
+		wantKeys []string
+	}{
+		{
+			server:   "mariadb-0",
+			wantKeys: []string{"mariadb-0.mariadb-bin.000001"},
+		},
+		{
+			server:   "mariadb-0",
+			wantKeys: []string{"mariadb-0.mariadb-bin.000001"},
+		},
+		{
+			server:   "mariadb-1",
+			wantKeys: []string{"mariadb-0.mariadb-bin.000001", "mariadb-1.mariadb-bin.000001"},
+		},
+	}
+	for i, step := range steps {
+		keys := archive(step.server)
+		if strings.Join(keys, ",") != strings.Join(step.wantKeys, ",") {
+			t.Fatalf("unexpected archived binlogs in step %d, expected: %v got: %v", i, step.wantKeys, keys)
+		}
+	}
+}
+
+func testMariaDB() *mariadbv1alpha1.MariaDB {
+	return &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Port: 3306,
+		},
+	}
+}
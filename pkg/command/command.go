@@ -34,19 +34,50 @@ func NewBashCommand(args []string) *Command {
 }
 
 func ConnectionFlags(co *CommandOpts, mariadb *mariadbv1alpha1.MariaDB) string {
+	return connectionFlags(co, mariadb, host(mariadb))
+}
+
+func PrimaryConnectionFlags(co *CommandOpts, mariadb *mariadbv1alpha1.MariaDB) string {
+	if !mariadb.IsHAEnabled() {
+		return ConnectionFlags(co, mariadb)
+	}
+	return connectionFlags(
+		co,
+		mariadb,
+		statefulset.ServiceFQDNWithService(mariadb.ObjectMeta, mariadb.PrimaryServiceKey().Name),
+	)
+}
+
+func PodConnectionFlags(co *CommandOpts, mariadb *mariadbv1alpha1.MariaDB, podIndex int) string {
+	return connectionFlags(
+		co,
+		mariadb,
+		statefulset.PodFQDNWithService(mariadb.ObjectMeta, podIndex, mariadb.InternalServiceKey().Name),
+	)
+}
+
+func connectionFlags(co *CommandOpts, mariadb *mariadbv1alpha1.MariaDB, host string) string {
 	flags := fmt.Sprintf(
 		"--user=${%s} --password=${%s} --host=%s --port=%d",
 		co.UserEnv,
 		co.PasswordEnv,
-		host(mariadb),
+		host,
 		mariadb.Spec.Port,
 	)
+	if mariadb.IsTLSEnabled() {
+		flags += " --ssl"
+	}
 	if co.Database != nil {
 		flags += fmt.Sprintf(" --database=%s", *co.Database)
 	}
 	return flags
 }
 
+// shellQuote quotes a string to be used as a single word in a bash script, so it is not interpreted by the shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 func host(mariadb *mariadbv1alpha1.MariaDB) string {
 	if mariadb.Replication().Enabled {
 		return statefulset.ServiceFQDNWithService(
@@ -55,13 +55,17 @@ func SetCompleteWithCronJob(c Conditioner, cronJob *batchv1.CronJob) {
 }
 
 func SetCompleteWithJob(c Conditioner, job *batchv1.Job) {
+	SetCompleteWithJobAndFailedMessage(c, job, "Failed")
+}
+
+func SetCompleteWithJobAndFailedMessage(c Conditioner, job *batchv1.Job, failedMessage string) {
 	switch getJobConditionType(job) {
 	case batchv1.JobFailed:
 		c.SetCondition(metav1.Condition{
 			Type:    mariadbv1alpha1.ConditionTypeComplete,
 			Status:  metav1.ConditionTrue,
 			Reason:  mariadbv1alpha1.ConditionReasonJobFailed,
-			Message: "Failed",
+			Message: failedMessage,
 		})
 	case batchv1.JobComplete:
 		c.SetCondition(metav1.Condition{
@@ -87,6 +91,33 @@ func SetCompleteWithJob(c Conditioner, job *batchv1.Job) {
 	}
 }
 
+func SetCompleteWithVolumeSnapshot(c Conditioner, snapshot *mariadbv1alpha1.BackupVolumeSnapshotArtifact) {
+	if snapshot == nil {
+		c.SetCondition(metav1.Condition{
+			Type:    mariadbv1alpha1.ConditionTypeComplete,
+			Status:  metav1.ConditionFalse,
+			Reason:  mariadbv1alpha1.ConditionReasonVolumeSnapshotScheduled,
+			Message: "Scheduled",
+		})
+		return
+	}
+	if !snapshot.ReadyToUse {
+		c.SetCondition(metav1.Condition{
+			Type:    mariadbv1alpha1.ConditionTypeComplete,
+			Status:  metav1.ConditionFalse,
+			Reason:  mariadbv1alpha1.ConditionReasonVolumeSnapshotNotReady,
+			Message: "Running",
+		})
+		return
+	}
+	c.SetCondition(metav1.Condition{
+		Type:    mariadbv1alpha1.ConditionTypeComplete,
+		Status:  metav1.ConditionTrue,
+		Reason:  mariadbv1alpha1.ConditionReasonVolumeSnapshotReady,
+		Message: "Success",
+	})
+}
+
 func SetCompleteFailedWithMessage(c Conditioner, message string) {
 	c.SetCondition(metav1.Condition{
 		Type:    mariadbv1alpha1.ConditionTypeComplete,
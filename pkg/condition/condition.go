@@ -97,6 +97,11 @@ func (p *Complete) PatcherWithCronJob(ctx context.Context, err error, key types.
 }
 
 func (p *Complete) PatcherWithJob(ctx context.Context, err error, key types.NamespacedName) (Patcher, error) {
+	return p.PatcherWithJobAndFailedMessage(ctx, err, key, "Failed")
+}
+
+func (p *Complete) PatcherWithJobAndFailedMessage(ctx context.Context, err error, key types.NamespacedName,
+	failedMessage string) (Patcher, error) {
 	if err != nil {
 		return func(c Conditioner) {
 			SetCompleteFailedWithMessage(c, "Error creating Job")
@@ -108,7 +113,7 @@ func (p *Complete) PatcherWithJob(ctx context.Context, err error, key types.Name
 		return nil, err
 	}
 	return func(c Conditioner) {
-		SetCompleteWithJob(c, &job)
+		SetCompleteWithJobAndFailedMessage(c, &job, failedMessage)
 	}, nil
 }
 
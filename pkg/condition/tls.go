@@ -0,0 +1,24 @@
+package conditions
+
+import (
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func SetTLSCertificatesExpiring(c Conditioner, msg string) {
+	c.SetCondition(metav1.Condition{
+		Type:    mariadbv1alpha1.ConditionTypeTLSCertificatesExpiring,
+		Status:  metav1.ConditionTrue,
+		Reason:  mariadbv1alpha1.ConditionReasonTLSCertificatesExpiring,
+		Message: msg,
+	})
+}
+
+func SetTLSCertificatesValid(c Conditioner) {
+	c.SetCondition(metav1.Condition{
+		Type:    mariadbv1alpha1.ConditionTypeTLSCertificatesExpiring,
+		Status:  metav1.ConditionFalse,
+		Reason:  mariadbv1alpha1.ConditionReasonTLSCertificatesValid,
+		Message: "TLS certificates valid",
+	})
+}
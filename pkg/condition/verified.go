@@ -0,0 +1,33 @@
+package conditions
+
+import (
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func SetVerifiedWithJob(c Conditioner, job *batchv1.Job) {
+	switch getJobConditionType(job) {
+	case batchv1.JobFailed:
+		c.SetCondition(metav1.Condition{
+			Type:    mariadbv1alpha1.ConditionTypeVerified,
+			Status:  metav1.ConditionFalse,
+			Reason:  mariadbv1alpha1.ConditionReasonJobFailed,
+			Message: "Verification failed",
+		})
+	case batchv1.JobComplete:
+		c.SetCondition(metav1.Condition{
+			Type:    mariadbv1alpha1.ConditionTypeVerified,
+			Status:  metav1.ConditionTrue,
+			Reason:  mariadbv1alpha1.ConditionReasonJobComplete,
+			Message: "Verified",
+		})
+	default:
+		c.SetCondition(metav1.Condition{
+			Type:    mariadbv1alpha1.ConditionTypeVerified,
+			Status:  metav1.ConditionFalse,
+			Reason:  mariadbv1alpha1.ConditionReasonJobRunning,
+			Message: "Verifying",
+		})
+	}
+}
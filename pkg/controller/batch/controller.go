@@ -2,16 +2,19 @@ package batch
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
 	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
+	"github.com/mariadb-operator/mariadb-operator/pkg/health"
 	"github.com/mariadb-operator/mariadb-operator/pkg/refresolver"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type BatchReconciler struct {
@@ -39,8 +42,33 @@ func (r *BatchReconciler) Reconcile(ctx context.Context, parentObj client.Object
 	return nil
 }
 
+// ReconcileBinlogArchive reconciles the CronJob that archives the binary logs of a Backup.
+func (r *BatchReconciler) ReconcileBinlogArchive(ctx context.Context, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB) error {
+	key := backup.BinlogArchiveKey()
+	if !backup.IsBinlogArchiveEnabled() {
+		var existingCronJob batchv1.CronJob
+		if err := r.Get(ctx, key, &existingCronJob); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if err := r.Delete(ctx, &existingCronJob); err != nil {
+			return fmt.Errorf("error deleting binlog archive CronJob: %v", err)
+		}
+		return nil
+	}
+
+	desiredCronJob, err := r.builder.BuildBinlogArchiveCronJob(key, backup, mariadb)
+	if err != nil {
+		return fmt.Errorf("error building binlog archive CronJob: %v", err)
+	}
+	return r.reconcileCronJob(ctx, key, desiredCronJob)
+}
+
 func (r *BatchReconciler) reconcileStorage(ctx context.Context, parentObj client.Object,
 	mariadb *mariadbv1alpha1.MariaDB) error {
+	if restore, ok := parentObj.(*mariadbv1alpha1.Restore); ok {
+		return r.reconcileRestoreStorage(ctx, restore, mariadb)
+	}
 	backup, ok := parentObj.(*mariadbv1alpha1.Backup)
 	if !ok {
 		return nil
@@ -66,6 +94,31 @@ func (r *BatchReconciler) reconcileStorage(ctx context.Context, parentObj client
 	return r.Create(ctx, pvc)
 }
 
+// reconcileRestoreStorage creates the storage PVC of the first MariaDB Pod in advance,
+// so the physical backup can be copied back into the datadir before the StatefulSet is created.
+func (r *BatchReconciler) reconcileRestoreStorage(ctx context.Context, restore *mariadbv1alpha1.Restore,
+	mariadb *mariadbv1alpha1.MariaDB) error {
+	if !restore.Spec.RestoreSource.IsPhysical() {
+		return nil
+	}
+
+	key := mariadb.StoragePVCKey(0)
+	var existingPvc corev1.PersistentVolumeClaim
+	err := r.Get(ctx, key, &existingPvc)
+	if err == nil {
+		return nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error getting PersistentVolumeClaim: %v", err)
+	}
+
+	pvc, err := r.builder.BuildStoragePVC(key, mariadb)
+	if err != nil {
+		return fmt.Errorf("error building storage PVC: %v", err)
+	}
+	return r.Create(ctx, pvc)
+}
+
 func (r *BatchReconciler) reconcileBatch(ctx context.Context, parentObj client.Object,
 	mariadb *mariadbv1alpha1.MariaDB) error {
 	key := client.ObjectKeyFromObject(parentObj)
@@ -88,10 +141,14 @@ func (r *BatchReconciler) buildBatch(ctx context.Context, parentObj client.Objec
 	mariadb *mariadbv1alpha1.MariaDB) (client.Object, error) {
 	key := client.ObjectKeyFromObject(parentObj)
 	if backup, ok := parentObj.(*mariadbv1alpha1.Backup); ok {
+		podIndex, err := r.BackupPodIndex(ctx, backup, mariadb)
+		if err != nil {
+			return nil, fmt.Errorf("error getting Backup target: %v", err)
+		}
 		if backup.Spec.Schedule != nil {
-			return r.builder.BuildBackupCronJob(key, backup, mariadb)
+			return r.builder.BuildBackupCronJob(key, backup, mariadb, podIndex)
 		}
-		return r.builder.BuildBackupJob(key, backup, mariadb)
+		return r.builder.BuildBackupJob(key, backup, mariadb, podIndex)
 	}
 
 	if restore, ok := parentObj.(*mariadbv1alpha1.Restore); ok {
@@ -101,6 +158,31 @@ func (r *BatchReconciler) buildBatch(ctx context.Context, parentObj client.Objec
 	return nil, fmt.Errorf("unable to build batch object using type: '%T'", parentObj)
 }
 
+// BackupPodIndex returns the index of the replica Pod where the Backup should be taken from.
+// A nil index is returned when the Backup should be taken from the primary.
+func (r *BatchReconciler) BackupPodIndex(ctx context.Context, backup *mariadbv1alpha1.Backup,
+	mariadb *mariadbv1alpha1.MariaDB) (*int, error) {
+	if !backup.IsReplicaTarget() {
+		return nil, nil
+	}
+	if !mariadb.IsHAEnabled() {
+		if backup.Spec.Target == mariadbv1alpha1.BackupTargetReplica {
+			return nil, errors.New("'Replica' target is only supported by MariaDBs with replication or Galera enabled")
+		}
+		return nil, nil
+	}
+
+	podIndex, err := health.HealthyMariaDBReplica(ctx, r, mariadb)
+	if err != nil {
+		if errors.Is(err, health.ErrNoHealthyInstancesAvailable) && backup.Spec.Target == mariadbv1alpha1.BackupTargetPreferReplica {
+			log.FromContext(ctx).V(1).Info("No healthy replicas available. Taking Backup from primary")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting healthy replica: %v", err)
+	}
+	return podIndex, nil
+}
+
 func (r *BatchReconciler) reconcileJob(ctx context.Context, key types.NamespacedName,
 	desiredJob *batchv1.Job) error {
 
@@ -144,6 +226,10 @@ func (r *BatchReconciler) reconcileCronJob(ctx context.Context, key types.Namesp
 	existingCronJob.Spec.Schedule = desiredCronJob.Spec.Schedule
 	existingCronJob.Spec.Suspend = desiredCronJob.Spec.Suspend
 	existingCronJob.Spec.JobTemplate.Spec.BackoffLimit = desiredCronJob.Spec.JobTemplate.Spec.BackoffLimit
+	existingCronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers = desiredCronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers
+	existingCronJob.Spec.JobTemplate.Spec.Template.Spec.Containers = desiredCronJob.Spec.JobTemplate.Spec.Template.Spec.Containers
+	existingCronJob.Spec.JobTemplate.Spec.Template.Spec.Affinity = desiredCronJob.Spec.JobTemplate.Spec.Template.Spec.Affinity
+	existingCronJob.Spec.JobTemplate.Spec.Template.Spec.Volumes = desiredCronJob.Spec.JobTemplate.Spec.Template.Spec.Volumes
 
 	if err := r.Patch(ctx, &existingCronJob, patch); err != nil {
 		return fmt.Errorf("error patching CronJob: %v", err)
@@ -0,0 +1,230 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	labels "github.com/mariadb-operator/mariadb-operator/pkg/builder/labels"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBackupPodIndex(t *testing.T) {
+	standalone := testMariaDB(nil)
+	replication := testMariaDB(&mariadbv1alpha1.Replication{
+		Enabled: true,
+	})
+
+	tests := []struct {
+		name         string
+		target       mariadbv1alpha1.BackupTarget
+		mariadb      *mariadbv1alpha1.MariaDB
+		pods         []client.Object
+		wantPodIndex *int
+		wantErr      bool
+	}{
+		{
+			name:         "primary",
+			target:       mariadbv1alpha1.BackupTargetPrimary,
+			mariadb:      replication,
+			pods:         []client.Object{testPod(replication, 0, true), testPod(replication, 1, true)},
+			wantPodIndex: nil,
+			wantErr:      false,
+		},
+		{
+			name:         "replica without HA",
+			target:       mariadbv1alpha1.BackupTargetReplica,
+			mariadb:      standalone,
+			wantPodIndex: nil,
+			wantErr:      true,
+		},
+		{
+			name:         "prefer replica without HA",
+			target:       mariadbv1alpha1.BackupTargetPreferReplica,
+			mariadb:      standalone,
+			wantPodIndex: nil,
+			wantErr:      false,
+		},
+		{
+			name:    "replica",
+			target:  mariadbv1alpha1.BackupTargetReplica,
+			mariadb: replication,
+			pods: []client.Object{
+				testPod(replication, 0, true),
+				testPod(replication, 1, false),
+				testPod(replication, 2, true),
+			},
+			wantPodIndex: ptr.To(2),
+			wantErr:      false,
+		},
+		{
+			name:         "replica without healthy replicas",
+			target:       mariadbv1alpha1.BackupTargetReplica,
+			mariadb:      replication,
+			pods:         []client.Object{testPod(replication, 0, true), testPod(replication, 1, false)},
+			wantPodIndex: nil,
+			wantErr:      true,
+		},
+		{
+			name:         "prefer replica without healthy replicas",
+			target:       mariadbv1alpha1.BackupTargetPreferReplica,
+			mariadb:      replication,
+			pods:         []client.Object{testPod(replication, 0, true), testPod(replication, 1, false)},
+			wantPodIndex: nil,
+			wantErr:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &BatchReconciler{
+				Client: fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(tt.pods...).Build(),
+			}
+			backup := &mariadbv1alpha1.Backup{
+				Spec: mariadbv1alpha1.BackupSpec{
+					Target: tt.target,
+				},
+			}
+			podIndex, err := r.BackupPodIndex(context.Background(), backup, tt.mariadb.DeepCopy())
+			if tt.wantErr && err == nil {
+				t.Fatal("expecting error to be non nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expecting error to be nil, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantPodIndex, podIndex) {
+				t.Fatalf("unexpected Pod index, expected: %v got: %v", ptr.Deref(tt.wantPodIndex, -1), ptr.Deref(podIndex, -1))
+			}
+		})
+	}
+}
+
+func TestReconcileCronJobRetarget(t *testing.T) {
+	ctx := context.Background()
+	existingCronJob := testCronJob("storage-mariadb-1", "mariadb-1")
+	r := &BatchReconciler{
+		Client: fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(existingCronJob).Build(),
+	}
+
+	desiredCronJob := testCronJob("storage-mariadb-2", "mariadb-2")
+	if err := r.reconcileCronJob(ctx, client.ObjectKeyFromObject(desiredCronJob), desiredCronJob); err != nil {
+		t.Fatalf("unexpected error reconciling CronJob: %v", err)
+	}
+
+	var cronJob batchv1.CronJob
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desiredCronJob), &cronJob); err != nil {
+		t.Fatalf("unexpected error getting CronJob: %v", err)
+	}
+	podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	desiredPodSpec := desiredCronJob.Spec.JobTemplate.Spec.Template.Spec
+	if !reflect.DeepEqual(desiredPodSpec.Volumes, podSpec.Volumes) {
+		t.Fatalf("unexpected volumes, expected: %v got: %v", desiredPodSpec.Volumes, podSpec.Volumes)
+	}
+	if !reflect.DeepEqual(desiredPodSpec.Affinity, podSpec.Affinity) {
+		t.Fatalf("unexpected affinity, expected: %v got: %v", desiredPodSpec.Affinity, podSpec.Affinity)
+	}
+}
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding client-go scheme: %v", err)
+	}
+	if err := mariadbv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error adding mariadb scheme: %v", err)
+	}
+	return scheme
+}
+
+func testMariaDB(replication *mariadbv1alpha1.Replication) *mariadbv1alpha1.MariaDB {
+	return &mariadbv1alpha1.MariaDB{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mariadb",
+			Namespace: "default",
+		},
+		Spec: mariadbv1alpha1.MariaDBSpec{
+			Replication: replication,
+			Replicas:    3,
+		},
+		Status: mariadbv1alpha1.MariaDBStatus{
+			CurrentPrimaryPodIndex: ptr.To(0),
+		},
+	}
+}
+
+func testPod(mariadb *mariadbv1alpha1.MariaDB, index int, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", mariadb.Name, index),
+			Namespace: mariadb.Namespace,
+			Labels: labels.NewLabelsBuilder().
+				WithMariaDB(mariadb).
+				Build(),
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.PodReady,
+					Status: status,
+				},
+			},
+		},
+	}
+}
+
+func testCronJob(pvcName, hostname string) *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "default",
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "*/1 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Affinity: &corev1.Affinity{
+								PodAffinity: &corev1.PodAffinity{
+									RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+										{
+											LabelSelector: &metav1.LabelSelector{
+												MatchLabels: map[string]string{
+													"statefulset.kubernetes.io/pod-name": hostname,
+												},
+											},
+											TopologyKey: corev1.LabelHostname,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "mariadb-storage",
+									VolumeSource: corev1.VolumeSource{
+										PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+											ClaimName: pvcName,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
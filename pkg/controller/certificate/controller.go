@@ -14,9 +14,9 @@ import (
 )
 
 var (
-	defaultCAValidityDuration   = 4 * 365 * 24 * time.Hour
-	defaultCertValidityDuration = 365 * 24 * time.Hour
-	defaultLookaheadValidity    = 90 * 24 * time.Hour
+	DefaultCAValidity        = 4 * 365 * 24 * time.Hour
+	DefaultCertValidity      = 365 * 24 * time.Hour
+	DefaultLookaheadValidity = 90 * 24 * time.Hour
 )
 
 type CertReconcilerOpts struct {
@@ -28,8 +28,11 @@ type CertReconcilerOpts struct {
 	certCommonName string
 	certDNSNames   []string
 	certValidity   time.Duration
+	certClientAuth bool
 
 	lookaheadValidity time.Duration
+
+	ownerReferences []metav1.OwnerReference
 }
 
 type CertReconcilerOpt func(opts *CertReconcilerOpts)
@@ -46,12 +49,25 @@ func WithCertValidity(validity time.Duration) CertReconcilerOpt {
 	}
 }
 
+// WithCertClientAuth issues a certificate that can also be used for client authentication.
+func WithCertClientAuth() CertReconcilerOpt {
+	return func(opts *CertReconcilerOpts) {
+		opts.certClientAuth = true
+	}
+}
+
 func WithLookaheadValidity(validity time.Duration) CertReconcilerOpt {
 	return func(opts *CertReconcilerOpts) {
 		opts.lookaheadValidity = validity
 	}
 }
 
+func WithOwnerReferences(ownerReferences ...metav1.OwnerReference) CertReconcilerOpt {
+	return func(opts *CertReconcilerOpts) {
+		opts.ownerReferences = ownerReferences
+	}
+}
+
 type CertReconciler struct {
 	client.Client
 	CertReconcilerOpts
@@ -63,14 +79,14 @@ func NewCertReconciler(client client.Client, caSecretKey types.NamespacedName, c
 	opts := CertReconcilerOpts{
 		caSecretKey:  caSecretKey,
 		caCommonName: caCommonName,
-		caValidity:   defaultCAValidityDuration,
+		caValidity:   DefaultCAValidity,
 
 		certSecretKey:  certSecretKey,
 		certCommonName: certCommonName,
-		certValidity:   defaultCertValidityDuration,
+		certValidity:   DefaultCertValidity,
 		certDNSNames:   certDNSNames,
 
-		lookaheadValidity: defaultLookaheadValidity,
+		lookaheadValidity: DefaultLookaheadValidity,
 	}
 	for _, setOpt := range reconcilerOpts {
 		setOpt(&opts)
@@ -112,6 +128,9 @@ func (r *CertReconciler) Reconcile(ctx context.Context) (*ReconcileResult, error
 	}
 
 	valid, err = pki.ValidCert(result.CAKeyPair.Cert, result.CertKeyPair, r.certCommonName, r.lookaheadTime())
+	if valid && err == nil && r.certClientAuth {
+		valid = pki.HasClientAuth(result.CertKeyPair.Cert)
+	}
 	if result.RefreshedCA || !valid || err != nil {
 		result.CertKeyPair, result.RefreshedCert, err = r.reconcileKeyPair(ctx, r.certSecretKey, true, createCert)
 		if err != nil {
@@ -166,20 +185,24 @@ func (r *CertReconciler) createCA() (*pki.KeyPair, error) {
 
 func (r *CertReconciler) createCertFn(caKeyPair *pki.KeyPair) func() (*pki.KeyPair, error) {
 	return func() (*pki.KeyPair, error) {
-		return pki.CreateCert(
-			caKeyPair,
+		opts := []pki.X509Opt{
 			pki.WithCommonName(r.certCommonName),
 			pki.WithDNSNames(r.certDNSNames),
-			pki.WithNotBefore(time.Now().Add(-1*time.Hour)),
+			pki.WithNotBefore(time.Now().Add(-1 * time.Hour)),
 			pki.WithNotAfter(time.Now().Add(r.certValidity)),
-		)
+		}
+		if r.certClientAuth {
+			opts = append(opts, pki.WithClientAuth())
+		}
+		return pki.CreateCert(caKeyPair, opts...)
 	}
 }
 
 func (r *CertReconciler) createSecret(ctx context.Context, key types.NamespacedName, secret *corev1.Secret, keyPair *pki.KeyPair) error {
 	secret.ObjectMeta = metav1.ObjectMeta{
-		Name:      key.Name,
-		Namespace: key.Namespace,
+		Name:            key.Name,
+		Namespace:       key.Namespace,
+		OwnerReferences: r.ownerReferences,
 	}
 	secret.Type = corev1.SecretTypeTLS
 	keyPair.FillTLSSecret(secret)
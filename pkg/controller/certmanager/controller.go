@@ -0,0 +1,39 @@
+package certmanager
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type CertificateReconciler struct {
+	client.Client
+}
+
+func NewCertificateReconciler(client client.Client) *CertificateReconciler {
+	return &CertificateReconciler{
+		Client: client,
+	}
+}
+
+func (r *CertificateReconciler) Reconcile(ctx context.Context, desiredCert *unstructured.Unstructured) error {
+	key := client.ObjectKeyFromObject(desiredCert)
+	existingCert := &unstructured.Unstructured{}
+	existingCert.SetGroupVersionKind(desiredCert.GroupVersionKind())
+	if err := r.Get(ctx, key, existingCert); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting Certificate: %v", err)
+		}
+		if err := r.Create(ctx, desiredCert); err != nil {
+			return fmt.Errorf("error creating Certificate: %v", err)
+		}
+		return nil
+	}
+
+	patch := client.MergeFrom(existingCert.DeepCopy())
+	existingCert.Object["spec"] = desiredCert.Object["spec"]
+	return r.Patch(ctx, existingCert, patch)
+}
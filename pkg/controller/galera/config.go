@@ -0,0 +1,121 @@
+package galera
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/builder"
+	"github.com/mariadb-operator/mariadb-operator/pkg/controller/configmap"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ReconcileConfigMap reconciles the Galera configuration that is rendered by the operator,
+// which is loaded by MariaDB along with the configuration files managed by the init and agent containers.
+func (r *GaleraReconciler) ReconcileConfigMap(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) error {
+	if !mariadb.IsGaleraTLSEnabled() {
+		return nil
+	}
+	myCnf, err := r.getMyCnf(ctx, mariadb)
+	if err != nil {
+		return fmt.Errorf("error getting my.cnf: %v", err)
+	}
+	tlsConfig, err := galeraTLSConfig(mariadb, myCnf)
+	if err != nil {
+		return fmt.Errorf("error rendering Galera TLS config: %v", err)
+	}
+	configMapKeyRef := mariadb.GaleraConfigMapKeyRef()
+	req := configmap.ReconcileRequest{
+		Mariadb: mariadb,
+		Owner:   mariadb,
+		Key: types.NamespacedName{
+			Name:      configMapKeyRef.Name,
+			Namespace: mariadb.Namespace,
+		},
+		Data: map[string]string{
+			configMapKeyRef.Key: tlsConfig,
+		},
+	}
+	return r.configMapReconciler.Reconcile(ctx, &req)
+}
+
+func (r *GaleraReconciler) getMyCnf(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB) (string, error) {
+	if mariadb.Spec.MyCnf != nil {
+		return *mariadb.Spec.MyCnf, nil
+	}
+	if mariadb.Spec.MyCnfConfigMapKeyRef != nil {
+		return r.refResolver.ConfigMapKeyRef(ctx, mariadb.Spec.MyCnfConfigMapKeyRef, mariadb.Namespace)
+	}
+	return "", nil
+}
+
+var providerOptionsRegex = regexp.MustCompile(`(?m)^\s*wsrep[_-]provider[_-]options\s*=\s*(.*?)\s*$`)
+
+// providerOptions merges the wsrep_provider_options defined in my.cnf with the TLS ones. The Galera TLS config is loaded
+// after my.cnf, so the options defined by the user would otherwise be overridden.
+func providerOptions(myCnf string, tlsOpts [][2]string) string {
+	var opts []string
+	tlsKeys := make(map[string]bool, len(tlsOpts))
+	for _, opt := range tlsOpts {
+		tlsKeys[opt[0]] = true
+	}
+	if matches := providerOptionsRegex.FindAllStringSubmatch(myCnf, -1); len(matches) > 0 {
+		userOpts := strings.Trim(matches[len(matches)-1][1], `"'`)
+		for _, opt := range strings.Split(userOpts, ";") {
+			opt = strings.TrimSpace(opt)
+			if opt == "" {
+				continue
+			}
+			key, _, _ := strings.Cut(opt, "=")
+			if tlsKeys[strings.TrimSpace(key)] {
+				continue
+			}
+			opts = append(opts, opt)
+		}
+	}
+	for _, opt := range tlsOpts {
+		opts = append(opts, opt[0]+"="+opt[1])
+	}
+	return strings.Join(opts, ";")
+}
+
+func galeraTLSConfig(mariadb *mariadbv1alpha1.MariaDB, myCnf string) (string, error) {
+	tpl := template.Must(template.New("galera-tls").Parse(`[galera]
+wsrep_provider_options="{{ .ProviderOptions }}"
+{{- if .EncryptSST }}
+
+[sst]
+ssl-mode=VERIFY_CA
+ssl-ca={{ .CA }}
+ssl-cert={{ .Cert }}
+ssl-key={{ .Key }}
+{{- end }}
+`))
+	buf := new(bytes.Buffer)
+	err := tpl.Execute(buf, struct {
+		ProviderOptions string
+		CA              string
+		Cert            string
+		Key             string
+		EncryptSST      bool
+	}{
+		ProviderOptions: providerOptions(myCnf, [][2]string{
+			{"socket.ssl", "yes"},
+			{"socket.ssl_ca", builder.MariadbTLSCAPath},
+			{"socket.ssl_cert", builder.MariadbTLSCertPath},
+			{"socket.ssl_key", builder.MariadbTLSKeyPath},
+		}),
+		CA:         builder.MariadbTLSCAPath,
+		Cert:       builder.MariadbTLSCertPath,
+		Key:        builder.MariadbTLSKeyPath,
+		EncryptSST: *mariadb.Galera().SST == mariadbv1alpha1.SSTMariaBackup,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
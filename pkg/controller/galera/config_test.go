@@ -0,0 +1,78 @@
+package galera
+
+import (
+	"testing"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+)
+
+func TestGaleraTLSConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		sst     mariadbv1alpha1.SST
+		myCnf   string
+		wantCfg string
+	}{
+		{
+			name: "mariabackup",
+			sst:  mariadbv1alpha1.SSTMariaBackup,
+			wantCfg: `[galera]
+wsrep_provider_options="socket.ssl=yes;socket.ssl_ca=/etc/pki/mariadb/ca.crt;socket.ssl_cert=/etc/pki/mariadb/server.crt;socket.ssl_key=/etc/pki/mariadb/server.key"
+
+[sst]
+ssl-mode=VERIFY_CA
+ssl-ca=/etc/pki/mariadb/ca.crt
+ssl-cert=/etc/pki/mariadb/server.crt
+ssl-key=/etc/pki/mariadb/server.key
+`,
+		},
+		{
+			name: "rsync",
+			sst:  mariadbv1alpha1.SSTRsync,
+			wantCfg: `[galera]
+wsrep_provider_options="socket.ssl=yes;socket.ssl_ca=/etc/pki/mariadb/ca.crt;socket.ssl_cert=/etc/pki/mariadb/server.crt;socket.ssl_key=/etc/pki/mariadb/server.key"
+`,
+		},
+		{
+			name: "user provider options",
+			sst:  mariadbv1alpha1.SSTRsync,
+			myCnf: `[mariadb]
+bind-address=*
+wsrep_provider_options = "gcache.size=1G; socket.ssl=no;evs.suspect_timeout=PT10S"
+`,
+			wantCfg: `[galera]
+wsrep_provider_options="gcache.size=1G;evs.suspect_timeout=PT10S;socket.ssl=yes;socket.ssl_ca=/etc/pki/mariadb/ca.crt;` +
+				`socket.ssl_cert=/etc/pki/mariadb/server.crt;socket.ssl_key=/etc/pki/mariadb/server.key"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sst := tt.sst
+			mariadb := &mariadbv1alpha1.MariaDB{
+				Spec: mariadbv1alpha1.MariaDBSpec{
+					TLS: &mariadbv1alpha1.MariaDBTLS{
+						Enabled: true,
+					},
+					Galera: &mariadbv1alpha1.Galera{
+						Enabled: true,
+						GaleraSpec: mariadbv1alpha1.GaleraSpec{
+							SST: &sst,
+							TLS: &mariadbv1alpha1.GaleraTLS{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			}
+			cfg, err := galeraTLSConfig(mariadb, tt.myCnf)
+			if err != nil {
+				t.Fatalf("unexpected error rendering config: %v", err)
+			}
+			if cfg != tt.wantCfg {
+				t.Errorf("unexpected config, got:\n%v\nexpected:\n%v", cfg, tt.wantCfg)
+			}
+		})
+	}
+}
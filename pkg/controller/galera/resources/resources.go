@@ -4,6 +4,9 @@ var (
 	GaleraConfigVolume    = "galera"
 	GaleraConfigMountPath = "/etc/mysql/mariadb.conf.d"
 
+	GaleraTLSConfigVolume   = "galera-tls"
+	GaleraTLSConfigFileName = "3-tls.cnf"
+
 	GaleraClusterPortName = "cluster"
 	GaleraClusterPort     = int32(4444)
 	GaleraISTPortName     = "ist"
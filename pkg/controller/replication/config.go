@@ -154,6 +154,12 @@ func (r *ReplicationConfig) changeMaster(ctx context.Context, mariadb *mariadbv1
 		Gtid:     gtidString,
 		Retries:  *mariadb.Replication().Replica.ConnectionRetries,
 	}
+	if mariadb.IsTLSEnabled() {
+		changeMasterOpts.SSL = &sqlClient.ChangeMasterSSLOpts{
+			CAPath:           builder.MariadbTLSCAPath,
+			VerifyServerCert: true,
+		}
+	}
 	if err := client.ChangeMaster(ctx, changeMasterOpts); err != nil {
 		return fmt.Errorf("error changing master: %v", err)
 	}
@@ -240,12 +246,17 @@ func (r *ReplicationConfig) reconcileUserSql(ctx context.Context, mariadb *maria
 		return fmt.Errorf("error checking if replication user exists: %v", err)
 	}
 	if exists {
-		if err := client.AlterUser(ctx, opts.username, replPassword); err != nil {
+		alterOpts := sqlClient.AlterUserOpts{
+			IdentifiedBy: replPassword,
+			RequireSSL:   mariadb.IsTLSEnabled(),
+		}
+		if err := client.AlterUser(ctx, opts.username, alterOpts); err != nil {
 			return fmt.Errorf("error altering replication user: %v", err)
 		}
 	} else {
 		userOpts := sqlClient.CreateUserOpts{
 			IdentifiedBy: replPassword,
+			RequireSSL:   mariadb.IsTLSEnabled(),
 		}
 		if err := client.CreateUser(ctx, accountName, userOpts); err != nil {
 			return fmt.Errorf("error creating replication user: %v", err)
@@ -24,6 +24,14 @@ func (c *DiscoveryClient) ServiceMonitorExist() (bool, error) {
 	return c.resourceExist("monitoring.coreos.com/v1", "servicemonitors")
 }
 
+func (c *DiscoveryClient) CertificateExist() (bool, error) {
+	return c.resourceExist("cert-manager.io/v1", "certificates")
+}
+
+func (c *DiscoveryClient) VolumeSnapshotExist() (bool, error) {
+	return c.resourceExist("snapshot.storage.k8s.io/v1", "volumesnapshots")
+}
+
 func (c *DiscoveryClient) resourceExist(groupVersion, kind string) (bool, error) {
 	apiResourceList, err := c.ServerResourcesForGroupVersion(groupVersion)
 	if err != nil {
@@ -16,6 +16,7 @@ type Environment struct {
 	RelatedMariadbImage      string `env:"RELATED_IMAGE_MARIADB,required"`
 	RelatedMaxscaleImage     string `env:"RELATED_IMAGE_MAXSCALE,required"`
 	RelatedExporterImage     string `env:"RELATED_IMAGE_EXPORTER,required"`
+	RelatedMydumperImage     string `env:"RELATED_IMAGE_MYDUMPER,default=mydumper/mydumper:v0.16.1-3"`
 	WatchNamespace           string `env:"WATCH_NAMESPACE"`
 }
 
@@ -29,6 +29,7 @@ func TestWathcNamespaces(t *testing.T) {
 				"RELATED_IMAGE_MARIADB":      "mariadb:lts",
 				"RELATED_IMAGE_MAXSCALE":     "mariadb/maxscale:23.08",
 				"RELATED_IMAGE_EXPORTER":     "prom/mysqld-exporter:v0.15.1",
+				"RELATED_IMAGE_MYDUMPER":     "mydumper/mydumper:v0.16.1-3",
 			},
 			wantNamespaces: nil,
 			wantErr:        true,
@@ -43,6 +44,7 @@ func TestWathcNamespaces(t *testing.T) {
 				"RELATED_IMAGE_MARIADB":      "mariadb:lts",
 				"RELATED_IMAGE_MAXSCALE":     "mariadb/maxscale:23.08",
 				"RELATED_IMAGE_EXPORTER":     "prom/mysqld-exporter:v0.15.1",
+				"RELATED_IMAGE_MYDUMPER":     "mydumper/mydumper:v0.16.1-3",
 				"WATCH_NAMESPACE":            "",
 			},
 			wantNamespaces: nil,
@@ -58,6 +60,7 @@ func TestWathcNamespaces(t *testing.T) {
 				"RELATED_IMAGE_MARIADB":      "mariadb:lts",
 				"RELATED_IMAGE_MAXSCALE":     "mariadb/maxscale:23.08",
 				"RELATED_IMAGE_EXPORTER":     "prom/mysqld-exporter:v0.15.1",
+				"RELATED_IMAGE_MYDUMPER":     "mydumper/mydumper:v0.16.1-3",
 				"WATCH_NAMESPACE":            "ns1",
 			},
 			wantNamespaces: []string{"ns1"},
@@ -73,6 +76,7 @@ func TestWathcNamespaces(t *testing.T) {
 				"RELATED_IMAGE_MARIADB":      "mariadb:lts",
 				"RELATED_IMAGE_MAXSCALE":     "mariadb/maxscale:23.08",
 				"RELATED_IMAGE_EXPORTER":     "prom/mysqld-exporter:v0.15.1",
+				"RELATED_IMAGE_MYDUMPER":     "mydumper/mydumper:v0.16.1-3",
 				"WATCH_NAMESPACE":            "ns1,ns2,ns3",
 			},
 			wantNamespaces: []string{"ns1", "ns2", "ns3"},
@@ -27,6 +27,12 @@ func WithHTTPClient(httpClient *http.Client) Option {
 	}
 }
 
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = rt
+	}
+}
+
 func WithTimeout(timeout time.Duration) Option {
 	return func(c *Client) {
 		if timeout == 0 {
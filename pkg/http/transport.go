@@ -1,6 +1,11 @@
 package http
 
-import "net/http"
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
 
 type HeadersTransport struct {
 	roundTripper http.RoundTripper
@@ -28,3 +33,16 @@ func (t *HeadersTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	}
 	return t.roundTripper.RoundTrip(req)
 }
+
+func NewTLSTransport(caCert []byte) (http.RoundTripper, error) {
+	caPool := x509.NewCertPool()
+	if ok := caPool.AppendCertsFromPEM(caCert); !ok {
+		return nil, errors.New("unable to add CA certificate to pool")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    caPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	return transport, nil
+}
@@ -6,6 +6,7 @@ import (
 	"text/template"
 
 	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	maxscaleresources "github.com/mariadb-operator/mariadb-operator/pkg/maxscale/resources"
 	"k8s.io/utils/ptr"
 )
 
@@ -17,6 +18,9 @@ type tplOpts struct {
 	AdminPort             int32
 	AdminGui              bool
 	AdminSecureGui        bool
+	AdminSSLKey           string
+	AdminSSLCert          string
+	AdminSSLCA            string
 	Params                map[string]string
 }
 
@@ -28,6 +32,9 @@ var existingConfigKeys = map[string]struct{}{
 	"admin_port":              {},
 	"admin_gui":               {},
 	"admin_secure_gui":        {},
+	"admin_ssl_key":           {},
+	"admin_ssl_cert":          {},
+	"admin_ssl_ca":            {},
 }
 
 func Config(mxs *mariadbv1alpha1.MaxScale) ([]byte, error) {
@@ -39,20 +46,31 @@ admin_host={{ .AdminHost }}
 admin_port={{ .AdminPort }}
 admin_gui={{ .AdminGui }}
 admin_secure_gui={{ .AdminSecureGui }}
+{{- if .AdminSecureGui }}
+admin_ssl_key={{ .AdminSSLKey }}
+admin_ssl_cert={{ .AdminSSLCert }}
+admin_ssl_ca={{ .AdminSSLCA }}
+{{- end }}
 {{ range $key,$value := .Params }}
 {{- $key }}={{ $value }}
 {{ end }}`)
-	buf := new(bytes.Buffer)
-	err := tpl.Execute(buf, tplOpts{
+	opts := tplOpts{
 		Threads:               configValueOrDefault("threads", mxs.Spec.Config.Params, "auto"),
 		PersistRuntimeChanges: true,
 		LoadPersistentConfigs: true,
 		AdminHost:             configValueOrDefault("admin_host", mxs.Spec.Config.Params, "0.0.0.0"),
 		AdminPort:             mxs.Spec.Admin.Port,
 		AdminGui:              ptr.Deref(mxs.Spec.Admin.GuiEnabled, true),
-		AdminSecureGui:        false,
+		AdminSecureGui:        mxs.IsTLSEnabled(),
 		Params:                filterExistingConfig(mxs.Spec.Config.Params),
-	})
+	}
+	if mxs.IsTLSEnabled() {
+		opts.AdminSSLKey = maxscaleresources.TLSKeyPath
+		opts.AdminSSLCert = maxscaleresources.TLSCertPath
+		opts.AdminSSLCA = maxscaleresources.TLSCAPath
+	}
+	buf := new(bytes.Buffer)
+	err := tpl.Execute(buf, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error rendering MaxScale config: %v", err)
 	}
@@ -120,6 +120,36 @@ admin_host=0.0.0.0
 admin_port=8989
 admin_gui=true
 admin_secure_gui=false
+`,
+		},
+		{
+			name: "TLS",
+			mxs: &mariadbv1alpha1.MaxScale{
+				Spec: mariadbv1alpha1.MaxScaleSpec{
+					Config: mariadbv1alpha1.MaxScaleConfig{
+						Params: map[string]string{
+							"admin_ssl_key": "/etc/ssl/key.pem",
+						},
+					},
+					Admin: mariadbv1alpha1.MaxScaleAdmin{
+						Port: 8989,
+					},
+					TLS: &mariadbv1alpha1.MaxScaleTLS{
+						Enabled: true,
+					},
+				},
+			},
+			wantConfig: `[maxscale]
+threads=auto
+persist_runtime_changes=true
+load_persisted_configs=true
+admin_host=0.0.0.0
+admin_port=8989
+admin_gui=true
+admin_secure_gui=true
+admin_ssl_key=/etc/pki/maxscale/server.key
+admin_ssl_cert=/etc/pki/maxscale/server.crt
+admin_ssl_ca=/etc/pki/maxscale/ca.crt
 `,
 		},
 	}
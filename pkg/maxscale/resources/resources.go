@@ -0,0 +1,9 @@
+package resources
+
+var (
+	TLSMountPath    = "/etc/pki/maxscale"
+	TLSCAPath       = TLSMountPath + "/ca.crt"
+	TLSCertPath     = TLSMountPath + "/server.crt"
+	TLSKeyPath      = TLSMountPath + "/server.key"
+	TLSServerCAPath = TLSMountPath + "/mariadb-ca.crt"
+)
@@ -5,4 +5,7 @@ var (
 	GaleraAnnotation        = "mariadb.mmontes.io/galera"
 	MariadbAnnotation       = "mariadb.mmontes.io/mariadb"
 	WebhookConfigAnnotation = "mariadb.mmontes.io/webhook"
+	TLSCertHashAnnotation   = "mariadb.mmontes.io/tls-cert-hash"
+	BackupTimeAnnotation    = "mariadb.mmontes.io/backup-time"
+	BackupLabel             = "mariadb.mmontes.io/backup"
 )
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/backup"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace       = "mariadb_operator"
+	backupSubsystem = "backup"
+)
+
+var (
+	backupLabels = []string{"name", "namespace"}
+
+	// BackupLastSuccessTimestamp is the time of the last successful backup, which allows alerting on stale Backups.
+	BackupLastSuccessTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: backupSubsystem,
+			Name:      "last_success_timestamp_seconds",
+			Help:      "Unix timestamp of the last successful backup.",
+		},
+		backupLabels,
+	)
+	// BackupLastDuration is the time taken by the last successful backup.
+	BackupLastDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: backupSubsystem,
+			Name:      "last_duration_seconds",
+			Help:      "Time taken by the last successful backup.",
+		},
+		backupLabels,
+	)
+	// BackupLastSize is the size of the files taken by the last successful backup.
+	BackupLastSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: backupSubsystem,
+			Name:      "last_size_bytes",
+			Help:      "Size in bytes of the files taken by the last successful backup.",
+		},
+		backupLabels,
+	)
+	// BackupFiles is the number of backup files available in the storage.
+	BackupFiles = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: backupSubsystem,
+			Name:      "files",
+			Help:      "Number of backup files available in the storage.",
+		},
+		backupLabels,
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		BackupLastSuccessTimestamp,
+		BackupLastDuration,
+		BackupLastSize,
+		BackupFiles,
+	)
+}
+
+// SetBackupStatus updates the metrics that can be derived from the Backup status.
+func SetBackupStatus(b *mariadbv1alpha1.Backup) {
+	if b.Status.LastSuccessfulBackupTime == nil {
+		return
+	}
+	BackupLastSuccessTimestamp.WithLabelValues(b.Name, b.Namespace).Set(float64(b.Status.LastSuccessfulBackupTime.Unix()))
+}
+
+// SetBackupSummary updates the metrics reported by the backup Job.
+func SetBackupSummary(b *mariadbv1alpha1.Backup, summary *backup.CatalogSummary) {
+	if summary == nil {
+		return
+	}
+	BackupLastDuration.WithLabelValues(b.Name, b.Namespace).Set(summary.DurationSeconds)
+	BackupLastSize.WithLabelValues(b.Name, b.Namespace).Set(float64(summary.Size))
+	SetBackupFiles(b, summary.FileCount)
+}
+
+// SetBackupFiles updates the number of backup files available in the storage.
+func SetBackupFiles(b *mariadbv1alpha1.Backup, files int) {
+	BackupFiles.WithLabelValues(b.Name, b.Namespace).Set(float64(files))
+}
+
+// DeleteBackup deletes the metrics of a Backup, so they are no longer exposed after it is deleted.
+func DeleteBackup(key types.NamespacedName) {
+	for _, gaugeVec := range []*prometheus.GaugeVec{
+		BackupLastSuccessTimestamp,
+		BackupLastDuration,
+		BackupLastSize,
+		BackupFiles,
+	} {
+		gaugeVec.DeleteLabelValues(key.Name, key.Namespace)
+	}
+}
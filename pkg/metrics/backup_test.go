@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	mariadbv1alpha1 "github.com/mariadb-operator/mariadb-operator/api/v1alpha1"
+	"github.com/mariadb-operator/mariadb-operator/pkg/backup"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBackupMetrics(t *testing.T) {
+	lastSuccess := time.Date(2023, 12, 22, 15, 0, 0, 0, time.UTC)
+	b := &mariadbv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: "default",
+		},
+		Status: mariadbv1alpha1.BackupStatus{
+			LastSuccessfulBackupTime: &metav1.Time{Time: lastSuccess},
+		},
+	}
+
+	SetBackupStatus(b)
+	SetBackupSummary(b, &backup.CatalogSummary{
+		DurationSeconds: 30,
+		Size:            1024,
+		FileCount:       3,
+	})
+
+	if got := testutil.ToFloat64(BackupLastSuccessTimestamp.WithLabelValues(b.Name, b.Namespace)); got != float64(lastSuccess.Unix()) {
+		t.Errorf("unexpected last success timestamp, expected: %v got: %v", float64(lastSuccess.Unix()), got)
+	}
+	if got := testutil.ToFloat64(BackupLastDuration.WithLabelValues(b.Name, b.Namespace)); got != 30 {
+		t.Errorf("unexpected last duration, expected: %v got: %v", 30, got)
+	}
+	if got := testutil.ToFloat64(BackupLastSize.WithLabelValues(b.Name, b.Namespace)); got != 1024 {
+		t.Errorf("unexpected last size, expected: %v got: %v", 1024, got)
+	}
+	if got := testutil.ToFloat64(BackupFiles.WithLabelValues(b.Name, b.Namespace)); got != 3 {
+		t.Errorf("unexpected files, expected: %v got: %v", 3, got)
+	}
+
+	DeleteBackup(types.NamespacedName{Name: b.Name, Namespace: b.Namespace})
+	if count := testutil.CollectAndCount(BackupLastSuccessTimestamp); count != 0 {
+		t.Errorf("expected metrics to be deleted, got %d series", count)
+	}
+}
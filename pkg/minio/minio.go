@@ -14,6 +14,7 @@ type MinioOpts struct {
 	Region     string
 	TLS        bool
 	CACertPath string
+	IAM        bool
 }
 
 type MinioOpt func(m *MinioOpts)
@@ -31,6 +32,13 @@ func WithTLS(caCertPath string) MinioOpt {
 	}
 }
 
+// WithIAM obtains the credentials from the AWS IAM providers: web identity (IRSA), ECS task roles and EC2 instance profiles.
+func WithIAM() MinioOpt {
+	return func(m *MinioOpts) {
+		m.IAM = true
+	}
+}
+
 func NewMinioClient(endpoint string, mOpts ...MinioOpt) (*minio.Client, error) {
 	opts := MinioOpts{}
 	for _, setOpt := range mOpts {
@@ -55,7 +63,7 @@ func getMinioOptions(opts MinioOpts) (*minio.Options, error) {
 	}
 
 	minioOpts := &minio.Options{
-		Creds:     credentials.NewEnvAWS(),
+		Creds:     getCredentials(&opts),
 		Region:    opts.Region,
 		Secure:    opts.TLS,
 		Transport: transport,
@@ -63,6 +71,20 @@ func getMinioOptions(opts MinioOpts) (*minio.Options, error) {
 	return minioOpts, nil
 }
 
+func getCredentials(opts *MinioOpts) *credentials.Credentials {
+	if !opts.IAM {
+		return credentials.NewEnvAWS()
+	}
+	return credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.IAM{
+			Client: &http.Client{
+				Transport: http.DefaultTransport,
+			},
+		},
+	})
+}
+
 func getTransport(opts *MinioOpts) (*http.Transport, error) {
 	transport, err := minio.DefaultTransport(opts.TLS)
 	if err != nil {
@@ -87,6 +87,7 @@ type X509Opts struct {
 	Organization string
 	NotBefore    time.Time
 	NotAfter     time.Time
+	ClientAuth   bool
 }
 
 type X509Opt func(*X509Opts)
@@ -121,6 +122,13 @@ func WithNotAfter(notAfter time.Time) X509Opt {
 	}
 }
 
+// WithClientAuth allows the certificate to be used for client authentication, in addition to server authentication.
+func WithClientAuth() X509Opt {
+	return func(x *X509Opts) {
+		x.ClientAuth = true
+	}
+}
+
 func CreateCA(x509Opts ...X509Opt) (*KeyPair, error) {
 	opts := X509Opts{
 		CommonName:   "mariadb-operator",
@@ -161,6 +169,10 @@ func CreateCert(caKeyPair *KeyPair, x509Opts ...X509Opt) (*KeyPair, error) {
 		return nil, errors.New("CommonName and DNSNames are mandatory")
 	}
 
+	extKeyUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	if opts.ClientAuth {
+		extKeyUsage = append(extKeyUsage, x509.ExtKeyUsageClientAuth)
+	}
 	tpl := &x509.Certificate{
 		SerialNumber: big.NewInt(1),
 		Subject: pkix.Name{
@@ -170,12 +182,22 @@ func CreateCert(caKeyPair *KeyPair, x509Opts ...X509Opt) (*KeyPair, error) {
 		NotBefore:             opts.NotBefore,
 		NotAfter:              opts.NotAfter,
 		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		ExtKeyUsage:           extKeyUsage,
 		BasicConstraintsValid: true,
 	}
 	return createKeyPair(tpl, caKeyPair)
 }
 
+// HasClientAuth determines whether the certificate can be used for client authentication.
+func HasClientAuth(cert *x509.Certificate) bool {
+	for _, usage := range cert.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageClientAuth || usage == x509.ExtKeyUsageAny {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseCert(bytes []byte) (*x509.Certificate, error) {
 	pemBlockCert, _ := pem.Decode(bytes)
 	if pemBlockCert == nil {
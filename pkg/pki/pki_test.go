@@ -279,6 +279,33 @@ func TestCert(t *testing.T) {
 	}
 }
 
+func TestCertClientAuth(t *testing.T) {
+	caKeyPair, err := CreateCA()
+	if err != nil {
+		t.Fatalf("CA cert creation should succeed. Got error: %v", err)
+	}
+	x509Opts := []X509Opt{
+		WithCommonName("mariadb-galera"),
+		WithDNSNames([]string{"mariadb-galera"}),
+	}
+
+	keyPair, err := CreateCert(caKeyPair, x509Opts...)
+	if err != nil {
+		t.Fatalf("Certificate creation should succeed. Got error: %v", err)
+	}
+	if HasClientAuth(keyPair.Cert) {
+		t.Fatal("Expected cert not to allow client auth")
+	}
+
+	keyPair, err = CreateCert(caKeyPair, append(x509Opts, WithClientAuth())...)
+	if err != nil {
+		t.Fatalf("Certificate creation should succeed. Got error: %v", err)
+	}
+	if !HasClientAuth(keyPair.Cert) {
+		t.Fatal("Expected cert to allow client auth")
+	}
+}
+
 func TestParseCert(t *testing.T) {
 	tests := []struct {
 		name      string
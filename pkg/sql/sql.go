@@ -3,7 +3,10 @@ package sql
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strings"
@@ -18,7 +21,8 @@ import (
 )
 
 var (
-	ErrWaitReplicaTimeout = errors.New("timeout waiting for replica to be synced")
+	ErrWaitReplicaTimeout  = errors.New("timeout waiting for replica to be synced")
+	ErrFlushSSLUnsupported = errors.New("FLUSH SSL not supported")
 )
 
 type Opts struct {
@@ -29,6 +33,8 @@ type Opts struct {
 	Database string
 	Params   map[string]string
 	Timeout  *time.Duration
+
+	TLSCACert []byte
 }
 
 type Opt func(*Opts)
@@ -75,6 +81,12 @@ func WithTimeout(d time.Duration) Opt {
 	}
 }
 
+func WithTLSCACert(caCert []byte) Opt {
+	return func(o *Opts) {
+		o.TLSCACert = caCert
+	}
+}
+
 type Client struct {
 	db *sql.DB
 }
@@ -117,6 +129,13 @@ func NewClientWithMariaDB(ctx context.Context, mariadb *mariadbv1alpha1.MariaDB,
 		}()),
 		WithPort(mariadb.Spec.Port),
 	}
+	if mariadb.IsTLSEnabled() {
+		caCert, err := refResolver.SecretKeyRef(ctx, mariadb.TLSServerCASecretKeyRef(), mariadb.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error reading TLS CA secret: %v", err)
+		}
+		opts = append(opts, WithTLSCACert([]byte(caCert)))
+	}
 	opts = append(opts, clientOpts...)
 	return NewClient(opts...)
 }
@@ -159,10 +178,49 @@ func BuildDSN(opts Opts) (string, error) {
 	if opts.Params != nil {
 		config.Params = opts.Params
 	}
+	if opts.TLSCACert != nil {
+		tlsConfigName, err := registerTLSConfig(opts.Host, opts.TLSCACert)
+		if err != nil {
+			return "", fmt.Errorf("error registering TLS config: %v", err)
+		}
+		config.TLSConfig = tlsConfigName
+		config.Params = paramsWithoutTLS(opts.Params)
+	}
 
 	return config.FormatDSN(), nil
 }
 
+func registerTLSConfig(host string, caCert []byte) (string, error) {
+	caPool := x509.NewCertPool()
+	if ok := caPool.AppendCertsFromPEM(caCert); !ok {
+		return "", errors.New("unable to parse CA certificate")
+	}
+	name := fmt.Sprintf("mariadb-%s", host)
+	err := mysql.RegisterTLSConfig(name, &tls.Config{
+		RootCAs:    caPool,
+		ServerName: host,
+		MinVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func paramsWithoutTLS(params map[string]string) map[string]string {
+	if params == nil {
+		return nil
+	}
+	result := make(map[string]string, len(params))
+	for k, v := range params {
+		if k == "tls" {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
 func Connect(dsn string) (*sql.DB, error) {
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
@@ -204,6 +262,7 @@ func (c *Client) ExecFlushingPrivileges(ctx context.Context, sql string, args ..
 
 type CreateUserOpts struct {
 	IdentifiedBy       string
+	RequireSSL         bool
 	MaxUserConnections int32
 }
 
@@ -212,6 +271,9 @@ func (c *Client) CreateUser(ctx context.Context, accountName string, opts Create
 	if opts.IdentifiedBy != "" {
 		query += fmt.Sprintf("IDENTIFIED BY '%s' ", opts.IdentifiedBy)
 	}
+	if opts.RequireSSL {
+		query += "REQUIRE SSL "
+	}
 	if opts.MaxUserConnections != 0 {
 		query += fmt.Sprintf("WITH MAX_USER_CONNECTIONS %d ", opts.MaxUserConnections)
 	}
@@ -226,10 +288,23 @@ func (c *Client) DropUser(ctx context.Context, accountName string) error {
 	return c.ExecFlushingPrivileges(ctx, query)
 }
 
-func (c *Client) AlterUser(ctx context.Context, username, password string) error {
-	query := fmt.Sprintf("ALTER USER '%s'@'%s' IDENTIFIED BY '%s';", username, "%", password)
+type AlterUserOpts struct {
+	IdentifiedBy string
+	RequireSSL   bool
+}
 
-	return c.ExecFlushingPrivileges(ctx, query)
+func (c *Client) AlterUser(ctx context.Context, username string, opts AlterUserOpts) error {
+	return c.ExecFlushingPrivileges(ctx, alterUserQuery(username, opts))
+}
+
+func alterUserQuery(username string, opts AlterUserOpts) string {
+	query := fmt.Sprintf("ALTER USER '%s'@'%s' IDENTIFIED BY '%s' ", username, "%", opts.IdentifiedBy)
+	if opts.RequireSSL {
+		query += "REQUIRE SSL"
+	} else {
+		query += "REQUIRE NONE"
+	}
+	return query + ";"
 }
 
 func (c *Client) UserExists(ctx context.Context, username string) (bool, error) {
@@ -380,6 +455,54 @@ func (c *Client) UnlockTables(ctx context.Context) error {
 	return c.Exec(ctx, "UNLOCK TABLES;")
 }
 
+// TablesReadLock is a global read lock held by a dedicated connection, as the lock is released when the connection
+// that acquired it is closed or returned to the pool.
+type TablesReadLock struct {
+	conn *sql.Conn
+}
+
+// AcquireTablesReadLock flushes and locks the tables with a read lock using a dedicated connection.
+// The lock is held until it is released.
+func (c *Client) AcquireTablesReadLock(ctx context.Context) (*TablesReadLock, error) {
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting connection: %v", err)
+	}
+	if _, err := conn.ExecContext(ctx, "FLUSH TABLES WITH READ LOCK;"); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &TablesReadLock{
+		conn: conn,
+	}, nil
+}
+
+// Release unlocks the tables and returns the connection holding the lock to the pool.
+// If unlocking fails, the connection is discarded instead, as closing the session is the only way to release the lock.
+func (l *TablesReadLock) Release(ctx context.Context) error {
+	if _, err := l.conn.ExecContext(ctx, "UNLOCK TABLES;"); err != nil {
+		var errBundle *multierror.Error
+		errBundle = multierror.Append(errBundle, err)
+
+		if err := l.discardConn(); err != nil {
+			errBundle = multierror.Append(errBundle, err)
+		}
+		return errBundle.ErrorOrNil()
+	}
+	return l.conn.Close()
+}
+
+// discardConn closes the underlying connection rather than returning it to the pool.
+func (l *TablesReadLock) discardConn() error {
+	err := l.conn.Raw(func(driverConn any) error {
+		return driver.ErrBadConn
+	})
+	if err != nil && !errors.Is(err, driver.ErrBadConn) && !errors.Is(err, sql.ErrConnDone) {
+		return fmt.Errorf("error discarding connection: %v", err)
+	}
+	return nil
+}
+
 func (c *Client) EnableReadOnly(ctx context.Context) error {
 	return c.SetSystemVariable(ctx, "read_only", "1")
 }
@@ -431,22 +554,41 @@ type ChangeMasterOpts struct {
 	Password   string
 	Gtid       string
 	Retries    int
+	SSL        *ChangeMasterSSLOpts
+}
+
+type ChangeMasterSSLOpts struct {
+	CAPath           string
+	VerifyServerCert bool
 }
 
 func (c *Client) ChangeMaster(ctx context.Context, opts *ChangeMasterOpts) error {
+	query, err := changeMasterQuery(opts)
+	if err != nil {
+		return err
+	}
+	return c.Exec(ctx, query)
+}
+
+func changeMasterQuery(opts *ChangeMasterOpts) (string, error) {
 	tpl := createTpl("change-master.sql", `CHANGE MASTER '{{ .Connection }}' TO
 MASTER_HOST='{{ .Host }}',
 MASTER_USER='{{ .User }}',
 MASTER_PASSWORD='{{ .Password }}',
 MASTER_USE_GTID={{ .Gtid }},
+{{- with .SSL }}
+MASTER_SSL=1,
+MASTER_SSL_CA='{{ .CAPath }}',
+MASTER_SSL_VERIFY_SERVER_CERT={{ if .VerifyServerCert }}1{{ else }}0{{ end }},
+{{- end }}
 MASTER_CONNECT_RETRY={{ .Retries }};
 `)
 	buf := new(bytes.Buffer)
 	err := tpl.Execute(buf, opts)
 	if err != nil {
-		return fmt.Errorf("error generating change master query: %v", err)
+		return "", fmt.Errorf("error generating change master query: %v", err)
 	}
-	return c.Exec(ctx, buf.String())
+	return buf.String(), nil
 }
 
 func (c *Client) ResetSlavePos(ctx context.Context) error {
@@ -474,6 +616,42 @@ func (c *Client) StatusVariableInt(ctx context.Context, variable string) (int, e
 	return val, nil
 }
 
+// sslNotAfterLayout is the format used by OpenSSL to print certificate dates.
+const sslNotAfterLayout = "Jan _2 15:04:05 2006 MST"
+
+func (c *Client) SSLServerNotAfter(ctx context.Context) (*time.Time, error) {
+	val, err := c.StatusVariable(ctx, "Ssl_server_not_after")
+	if err != nil {
+		return nil, err
+	}
+	return parseSSLNotAfter(val)
+}
+
+func (c *Client) FlushSSL(ctx context.Context) error {
+	return flushSSLError(c.Exec(ctx, "FLUSH SSL;"))
+}
+
+func parseSSLNotAfter(val string) (*time.Time, error) {
+	notAfter, err := time.Parse(sslNotAfterLayout, val)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing 'Ssl_server_not_after': %v", err)
+	}
+	return &notAfter, nil
+}
+
+// flushSSLError translates the syntax error returned by the servers that do not support FLUSH SSL.
+func flushSSLError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1064 {
+		return ErrFlushSSLUnsupported
+	}
+	return err
+}
+
+func (c *Client) ReloadGaleraSSL(ctx context.Context) error {
+	return c.SetSystemVariable(ctx, "wsrep_provider_options", "'socket.ssl_reload=1'")
+}
+
 func (c *Client) GaleraClusterSize(ctx context.Context) (int, error) {
 	return c.StatusVariableInt(ctx, "wsrep_cluster_size")
 }
@@ -0,0 +1,224 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestChangeMasterQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *ChangeMasterOpts
+		wantQuery string
+	}{
+		{
+			name: "no SSL",
+			opts: &ChangeMasterOpts{
+				Connection: "mariadb-operator",
+				Host:       "mariadb-0.mariadb-internal.default.svc.cluster.local",
+				User:       "repl",
+				Password:   "password",
+				Gtid:       "CurrentPos",
+				Retries:    10,
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='mariadb-0.mariadb-internal.default.svc.cluster.local',
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_CONNECT_RETRY=10;
+`,
+		},
+		{
+			name: "SSL verifying server cert",
+			opts: &ChangeMasterOpts{
+				Connection: "mariadb-operator",
+				Host:       "mariadb-0.mariadb-internal.default.svc.cluster.local",
+				User:       "repl",
+				Password:   "password",
+				Gtid:       "CurrentPos",
+				Retries:    10,
+				SSL: &ChangeMasterSSLOpts{
+					CAPath:           "/etc/pki/mariadb/ca.crt",
+					VerifyServerCert: true,
+				},
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='mariadb-0.mariadb-internal.default.svc.cluster.local',
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=CurrentPos,
+MASTER_SSL=1,
+MASTER_SSL_CA='/etc/pki/mariadb/ca.crt',
+MASTER_SSL_VERIFY_SERVER_CERT=1,
+MASTER_CONNECT_RETRY=10;
+`,
+		},
+		{
+			name: "SSL without verifying server cert",
+			opts: &ChangeMasterOpts{
+				Connection: "mariadb-operator",
+				Host:       "mariadb-0.mariadb-internal.default.svc.cluster.local",
+				User:       "repl",
+				Password:   "password",
+				Gtid:       "SlavePos",
+				Retries:    5,
+				SSL: &ChangeMasterSSLOpts{
+					CAPath: "/etc/pki/mariadb/ca.crt",
+				},
+			},
+			wantQuery: `CHANGE MASTER 'mariadb-operator' TO
+MASTER_HOST='mariadb-0.mariadb-internal.default.svc.cluster.local',
+MASTER_USER='repl',
+MASTER_PASSWORD='password',
+MASTER_USE_GTID=SlavePos,
+MASTER_SSL=1,
+MASTER_SSL_CA='/etc/pki/mariadb/ca.crt',
+MASTER_SSL_VERIFY_SERVER_CERT=0,
+MASTER_CONNECT_RETRY=5;
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := changeMasterQuery(tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error generating query: %v", err)
+			}
+			if query != tt.wantQuery {
+				t.Errorf("unexpected query, got:\n%v\nexpected:\n%v", query, tt.wantQuery)
+			}
+		})
+	}
+}
+
+func TestAlterUserQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      AlterUserOpts
+		wantQuery string
+	}{
+		{
+			name: "require SSL",
+			opts: AlterUserOpts{
+				IdentifiedBy: "password",
+				RequireSSL:   true,
+			},
+			wantQuery: "ALTER USER 'repl'@'%' IDENTIFIED BY 'password' REQUIRE SSL;",
+		},
+		{
+			name: "require none",
+			opts: AlterUserOpts{
+				IdentifiedBy: "password",
+			},
+			wantQuery: "ALTER USER 'repl'@'%' IDENTIFIED BY 'password' REQUIRE NONE;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if query := alterUserQuery("repl", tt.opts); query != tt.wantQuery {
+				t.Errorf("unexpected query, got: %v expected: %v", query, tt.wantQuery)
+			}
+		})
+	}
+}
+
+func TestParseSSLNotAfter(t *testing.T) {
+	tests := []struct {
+		name         string
+		val          string
+		wantNotAfter time.Time
+		wantErr      bool
+	}{
+		{
+			name:         "double-digit day",
+			val:          "Dec 18 09:30:00 2025 GMT",
+			wantNotAfter: time.Date(2025, time.December, 18, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "single-digit day",
+			val:          "Jan  2 15:04:05 2026 GMT",
+			wantNotAfter: time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:    "empty",
+			val:     "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid layout",
+			val:     "2026-01-02T15:04:05Z",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notAfter, err := parseSSLNotAfter(tt.val)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expecting error, got: %v", notAfter)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if notAfter.Unix() != tt.wantNotAfter.Unix() {
+				t.Errorf("unexpected not after, got: %v expected: %v", notAfter, tt.wantNotAfter)
+			}
+		})
+	}
+}
+
+func TestFlushSSLError(t *testing.T) {
+	syntaxErr := &mysql.MySQLError{Number: 1064, Message: "You have an error in your SQL syntax"}
+	accessErr := &mysql.MySQLError{Number: 1227, Message: "Access denied"}
+	genericErr := errors.New("connection refused")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "no error",
+			err:     nil,
+			wantErr: nil,
+		},
+		{
+			name:    "syntax error",
+			err:     syntaxErr,
+			wantErr: ErrFlushSSLUnsupported,
+		},
+		{
+			name:    "wrapped syntax error",
+			err:     fmt.Errorf("error executing query: %w", syntaxErr),
+			wantErr: ErrFlushSSLUnsupported,
+		},
+		{
+			name:    "other MySQL error",
+			err:     accessErr,
+			wantErr: accessErr,
+		},
+		{
+			name:    "generic error",
+			err:     genericErr,
+			wantErr: genericErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := flushSSLError(tt.err); !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("unexpected error, got: %v expected: %v", err, tt.wantErr)
+			}
+		})
+	}
+}